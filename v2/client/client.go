@@ -3,23 +3,57 @@ package client
 import (
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/logging"
 )
 
-func GenerateClient(specification *spec.Spec, jsonmode string, moduleName string, generatePath string) *generator.Sources {
+func GenerateClient(specification *spec.Spec, jsonmode string, loggingLib string, moduleName string, generatePath string) (*generator.Sources, error) {
 	sources := generator.NewSources()
 
 	modules := NewModules(moduleName, generatePath, specification)
 	generator := NewGenerator(jsonmode, modules)
 
-	sources.AddGeneratedAll(generator.AllStaticFiles())
+	staticFiles, err := generator.AllStaticFiles()
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGeneratedAll(staticFiles)
+
+	loggingFile, err := logging.GenerateLogging(modules.Logging, loggingLib)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := generator.Credentials(specification.Security)
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGenerated(loggingFile, credentials)
 
-	sources.AddGeneratedAll(generator.ErrorModels(specification.HttpErrors))
-	sources.AddGenerated(generator.Errors(&specification.HttpErrors.Responses))
-	sources.AddGenerated(generator.ErrorsHandler(specification.HttpErrors.Responses))
+	errorModels, err := generator.ErrorModels(specification.HttpErrors)
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGeneratedAll(errorModels)
+	errors, err := generator.Errors(&specification.HttpErrors.Responses)
+	if err != nil {
+		return nil, err
+	}
+	errorsHandler, err := generator.ErrorsHandler(specification.HttpErrors.Responses)
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGenerated(errors, errorsHandler)
 
 	for _, version := range specification.Versions {
-		sources.AddGeneratedAll(generator.Models(&version))
-		sources.AddGeneratedAll(generator.Clients(&version))
+		models, err := generator.Models(&version)
+		if err != nil {
+			return nil, err
+		}
+		sources.AddGeneratedAll(models)
+		clients, err := generator.Clients(&version)
+		if err != nil {
+			return nil, err
+		}
+		sources.AddGeneratedAll(clients)
 	}
-	return sources
+	return sources, nil
 }
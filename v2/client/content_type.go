@@ -12,6 +12,8 @@ func ContentType(operation *spec.NamedOperation) string {
 		return `"text/plain"`
 	} else if operation.BodyIs(spec.RequestBodyJson) {
 		return `"application/json"`
+	} else if operation.BodyIs(spec.RequestBodyBinary) {
+		return `"application/octet-stream"`
 	} else if operation.BodyIs(spec.RequestBodyFormData) {
 		return `writer.FormDataContentType()`
 	} else if operation.BodyIs(spec.RequestBodyFormUrlEncoded) {
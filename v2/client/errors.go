@@ -6,10 +6,9 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
-func (g *Generator) Errors(errors *spec.ErrorResponses) *generator.CodeFile {
+func (g *Generator) Errors(errors *spec.ErrorResponses) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.HttpErrors, "errors.go")
 
-	w.Imports.Add("fmt")
 	w.Imports.Module(g.Modules.HttpErrorsModels)
 
 	for _, response := range *errors {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/specgen-io/specgen-golang/v2/empty"
+	"github.com/specgen-io/specgen-golang/v2/files"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
 	"github.com/specgen-io/specgen-golang/v2/models"
@@ -9,9 +10,9 @@ import (
 )
 
 type ClientGenerator interface {
-	Clients(version *spec.Version) []generator.CodeFile
-	ErrorsHandler(errors spec.ErrorResponses) *generator.CodeFile
-	ResponseHelperFunctions() *generator.CodeFile
+	Clients(version *spec.Version) ([]generator.CodeFile, error)
+	ErrorsHandler(errors spec.ErrorResponses) (*generator.CodeFile, error)
+	ResponseHelperFunctions() (*generator.CodeFile, error)
 }
 
 type Generator struct {
@@ -31,17 +32,36 @@ func NewGenerator(jsonmode string, modules *Modules) *Generator {
 	}
 }
 
-func (g *Generator) EmptyType() *generator.CodeFile {
+func (g *Generator) EmptyType() (*generator.CodeFile, error) {
 	return empty.GenerateEmpty(g.Modules.Empty)
 }
 
-func (g *Generator) AllStaticFiles() []generator.CodeFile {
-	return []generator.CodeFile{
-		*g.EnumsHelperFunctions(),
-		*g.EmptyType(),
-		*g.TypeConverter(),
-		*g.Params(),
-		*g.FormDataParams(),
-		*g.ResponseHelperFunctions(),
+func (g *Generator) FilesType() (*generator.CodeFile, error) {
+	return files.GenerateFiles(g.Modules.Files)
+}
+
+func (g *Generator) AllStaticFiles() ([]generator.CodeFile, error) {
+	generators := []func() (*generator.CodeFile, error){
+		g.EnumsHelperFunctions,
+		g.ValidationHelperFunctions,
+		g.EmptyType,
+		g.FilesType,
+		g.TypeConverter,
+		g.TypeParser,
+		g.Params,
+		g.FormDataParams,
+		g.CookieParams,
+		g.ResponseHelperFunctions,
+		g.CallOptions,
+		g.ClientOptions,
+	}
+	files := []generator.CodeFile{}
+	for _, generate := range generators {
+		file, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
 	}
+	return files, nil
 }
@@ -11,15 +11,21 @@ type Modules struct {
 	clients  map[string]map[string]module.Module
 	Root     module.Module
 	Empty    module.Module
+	Files    module.Module
 	Params   module.Module
 	Response module.Module
+	Options  module.Module
+	Logging  module.Module
 }
 
 func NewModules(moduleName string, generatePath string, specification *spec.Spec) *Modules {
 	root := module.New(moduleName, generatePath)
 	empty := root.Submodule("empty")
+	files := root.Submodule("files")
 	convert := root.Submodule("params")
 	response := root.Submodule("response")
+	options := root.Submodule("options")
+	logging := root.Submodule("logging")
 
 	clients := map[string]map[string]module.Module{}
 	for _, version := range specification.Versions {
@@ -35,8 +41,11 @@ func NewModules(moduleName string, generatePath string, specification *spec.Spec
 		clients,
 		root,
 		empty,
+		files,
 		convert,
 		response,
+		options,
+		logging,
 	}
 }
 
@@ -6,7 +6,6 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
 	"github.com/specgen-io/specgen-golang/v2/types"
-	"github.com/specgen-io/specgen-golang/v2/walkers"
 	"github.com/specgen-io/specgen-golang/v2/writer"
 	"strings"
 )
@@ -20,56 +19,33 @@ type NetHttpGenerator struct {
 	Types   *types.Types
 }
 
-func (g *NetHttpGenerator) Clients(version *spec.Version) []generator.CodeFile {
+func (g *NetHttpGenerator) Clients(version *spec.Version) ([]generator.CodeFile, error) {
 	files := []generator.CodeFile{}
 	for _, api := range version.Http.Apis {
-		files = append(files, *g.client(&api))
+		file, err := g.client(&api)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
 	}
-	return files
+	return files, nil
 }
 
-func (g *NetHttpGenerator) client(api *spec.Api) *generator.CodeFile {
+func (g *NetHttpGenerator) client(api *spec.Api) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Client(api), "client.go")
 
-	w.Imports.Add("fmt")
-	w.Imports.Add("errors")
-	w.Imports.Add("net/http")
-	w.Imports.Add("encoding/json")
-	w.Imports.AddAliased("github.com/sirupsen/logrus", "log")
-	if walkers.ApiHasBodyOfKind(api, spec.RequestBodyJson) || walkers.ApiHasBodyOfKind(api, spec.RequestBodyString) {
-		w.Imports.Add("bytes")
-	}
-	if walkers.ApiHasBodyOfKind(api, spec.RequestBodyFormData) {
-		w.Imports.Add("mime/multipart")
-	}
-	if walkers.ApiHasBodyOfKind(api, spec.RequestBodyFormUrlEncoded) {
-		w.Imports.Add("strings")
-		w.Imports.Add("net/url")
-	}
-	if walkers.ApiHasUrlParams(api) {
-		w.Imports.Module(g.Modules.Params)
-	}
-	if walkers.ApiHasMultiSuccessResponsesWithEmptyBody(api) {
-		w.Imports.Module(g.Modules.Empty)
-	}
-	if walkers.ApiHasType(api, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ApiHasType(api, spec.TypeJson) {
-		w.Imports.Add("encoding/json")
-	}
-	if walkers.ApiHasType(api, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ApiHasType(api, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
+	w.Imports.Module(g.Modules.Logging)
+	w.Imports.Module(g.Modules.Files)
+	w.Imports.Module(g.Modules.Params)
+	w.Imports.Module(g.Modules.Empty)
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
 	w.Imports.Module(g.Modules.HttpErrors)
-	if walkers.ApiIsUsingErrorModels(api) {
-		w.Imports.Module(g.Modules.HttpErrorsModels)
-	}
+	w.Imports.Module(g.Modules.HttpErrorsModels)
 	w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
 	w.Imports.Module(g.Modules.Response)
+	w.Imports.Module(g.Modules.Options)
 
 	for _, operation := range api.Operations {
 		responseStruct(w, g.Types, &operation)
@@ -86,25 +62,56 @@ func (g *NetHttpGenerator) client(api *spec.Api) *generator.CodeFile {
 
 func (g *NetHttpGenerator) clientWithCtor(w *writer.Writer) {
 	w.Line(`type %s struct {`, clientTypeName())
-	w.Line(`  baseUrl string`)
+	w.Line(`  baseUrl    string`)
+	w.Line(`  httpClient *http.Client`)
+	w.Line(`  options    *options.ClientOptions`)
 	w.Line(`}`)
 	w.EmptyLine()
-	w.Line(`func New%s(baseUrl string) *%s {`, casee.ToPascalCase(clientTypeName()), clientTypeName())
-	w.Line(`  return &%s{baseUrl}`, clientTypeName())
+	w.Line(`func New%s(baseUrl string, opts ...options.ClientOption) *%s {`, casee.ToPascalCase(clientTypeName()), clientTypeName())
+	w.Line(`  clientOptions := options.NewClientOptions(opts...)`)
+	w.Line(`  return &%s{baseUrl, clientOptions.Client(), clientOptions}`, clientTypeName())
 	w.Line(`}`)
 }
 
 func (g *NetHttpGenerator) operation(w *writer.Writer, operation *spec.NamedOperation) {
 	w.Line(`func (client *%s) %s {`, clientTypeName(), operationSignature(g.Types, operation))
-	w.Line(`  var %s = log.Fields{"operationId": "%s.%s", "method": "%s", "url": "%s"}`, logFieldsName(operation), operation.InApi.Name.Source, operation.Name.Source, casee.ToUpperCase(operation.Endpoint.Method), operation.FullUrl())
+	w.Line(`  var %s = logging.Fields{"operationId": "%s.%s", "method": "%s", "url": "%s"}`, logFieldsName(operation), operation.InApi.Name.Source, operation.Name.Source, casee.ToUpperCase(operation.Endpoint.Method), operation.FullUrl())
+	w.Line(`  callOptions := options.NewCallOptions(opts...)`)
+	w.Line(`  ctx, cancel := callOptions.Context(ctx)`)
+	if operationReturnsBody(operation) {
+		w.Line(`  closeWithBody := false`)
+		w.Line(`  defer func() {`)
+		w.Line(`    if !closeWithBody {`)
+		w.Line(`      cancel()`)
+		w.Line(`    }`)
+		w.Line(`  }()`)
+	} else {
+		w.Line(`  defer cancel()`)
+	}
+	w.EmptyLine()
 	g.createRequest(w, operation, `req`)
 	g.addQueryParams(w, operation, `req`)
 	g.addHeaderParams(w, operation, `req`)
+	g.addCookieParams(w, operation, `req`)
+	w.Line(`  client.options.Apply(%s)`, `req`)
+	if operation.IsSecured() {
+		w.Line(`  client.options.Authenticate(%s, %s)`, `req`, strings.Join(securitySchemesNames(operation), ", "))
+	}
+	w.Line(`  callOptions.Apply(%s)`, `req`)
+	w.EmptyLine()
 	g.sendRequest(w, operation, `req`, `resp`)
 	g.processResponses(w.Indented(), operation)
 	w.Line(`}`)
 }
 
+func securitySchemesNames(operation *spec.NamedOperation) []string {
+	names := []string{}
+	for _, name := range operation.EffectiveSecurity().SchemesNames() {
+		names = append(names, fmt.Sprintf(`"%s"`, name))
+	}
+	return names
+}
+
 func (g *NetHttpGenerator) createRequest(w *writer.Writer, operation *spec.NamedOperation, requestVar string) {
 	body := "nil"
 	if operation.BodyIs(spec.RequestBodyString) {
@@ -118,6 +125,9 @@ func (g *NetHttpGenerator) createRequest(w *writer.Writer, operation *spec.Named
 		w.Line(`  }`)
 		body = "bytes.NewBuffer(bodyData)"
 	}
+	if operation.BodyIs(spec.RequestBodyBinary) {
+		body = "body"
+	}
 	if operation.BodyIs(spec.RequestBodyFormData) {
 		w.Line(`  bodyData := &bytes.Buffer{}`)
 		w.Line(`  writer := multipart.NewWriter(bodyData)`)
@@ -127,7 +137,7 @@ func (g *NetHttpGenerator) createRequest(w *writer.Writer, operation *spec.Named
 		}
 		w.Line(`  err := f.CloseWriter()`)
 		w.Line(`  if err != nil {`)
-		w.Line(`    log.WithFields(%s).Error("Failed to write form-data params", err.Error())`, logFieldsName(operation))
+		w.Line(`    logging.Error(%s.With("error", err.Error()), "Failed to write form-data params")`, logFieldsName(operation))
 		w.Line(`    return %s`, operationError(operation, `err`))
 		w.Line(`  }`)
 		body = "bodyData"
@@ -141,25 +151,25 @@ func (g *NetHttpGenerator) createRequest(w *writer.Writer, operation *spec.Named
 		w.Line(`  bodyData := formUrlencodedValues.Encode()`)
 		body = "strings.NewReader(bodyData)"
 	}
-	w.Line(`  %s, err := http.NewRequest("%s", client.baseUrl+%s, %s)`, requestVar, operation.Endpoint.Method, g.addRequestUrlParams(operation), body)
+	w.Line(`  %s, err := http.NewRequestWithContext(ctx, "%s", client.baseUrl+%s, %s)`, requestVar, operation.Endpoint.Method, g.addRequestUrlParams(operation), body)
 	w.Line(`  if err != nil {`)
-	w.Line(`    log.WithFields(%s).Error("Failed to create HTTP request", err.Error())`, logFieldsName(operation))
+	w.Line(`    logging.Error(%s.With("error", err.Error()), "Failed to create HTTP request")`, logFieldsName(operation))
 	w.Line(`    return %s`, operationError(operation, `err`))
 	w.Line(`  }`)
-	if operation.BodyIs(spec.RequestBodyString) || operation.BodyIs(spec.RequestBodyJson) {
+	if !operation.BodyIs(spec.RequestBodyEmpty) {
 		w.Line(`  %s.Header.Set("Content-Type", %s)`, requestVar, ContentType(operation))
 	}
 	w.EmptyLine()
 }
 
 func (g *NetHttpGenerator) sendRequest(w *writer.Writer, operation *spec.NamedOperation, requestVar, responseVar string) {
-	w.Line(`  log.WithFields(%s).Info("Sending request")`, logFieldsName(operation))
-	w.Line(`  %s, err := http.DefaultClient.Do(%s)`, responseVar, requestVar)
+	w.Line(`  logging.Debug(%s, "Sending request")`, logFieldsName(operation))
+	w.Line(`  %s, err := client.httpClient.Do(%s)`, responseVar, requestVar)
 	w.Line(`  if err != nil {`)
-	w.Line(`    log.WithFields(%s).Error("Request failed", err.Error())`, logFieldsName(operation))
+	w.Line(`    logging.Error(%s.With("error", err.Error()), "Request failed")`, logFieldsName(operation))
 	w.Line(`    return %s`, operationError(operation, `err`))
 	w.Line(`  }`)
-	w.Line(`  log.WithFields(%s).WithField("status", %s.StatusCode).Info("Received response")`, logFieldsName(operation), responseVar)
+	w.Line(`  logging.Info(%s.With("status", %s.StatusCode), "Received response")`, logFieldsName(operation), responseVar)
 }
 
 func (g *NetHttpGenerator) getUrl(operation *spec.NamedOperation) []string {
@@ -218,25 +228,54 @@ func (g *NetHttpGenerator) addHeaderParams(w *writer.Writer, operation *spec.Nam
 	}
 }
 
+func (g *NetHttpGenerator) addCookieParams(w *writer.Writer, operation *spec.NamedOperation, requestVar string) {
+	if operation.CookieParams != nil && len(operation.CookieParams) > 0 {
+		w.Line(`  c := params.NewCookieParamsWriter(%s)`, requestVar)
+		for _, param := range operation.CookieParams {
+			w.Line(`  c.%s`, callTypesConverter(&param.Type.Definition, param.Name.Source, param.Name.CamelCase()))
+		}
+		w.EmptyLine()
+	}
+}
+
 func (g *NetHttpGenerator) processResponses(w *writer.Writer, operation *spec.NamedOperation) {
 	w.EmptyLine()
 	w.Line(`switch resp.StatusCode {`)
 	for _, response := range operation.Responses {
 		w.Line(`case %s:`, spec.HttpStatusCode(response.Name))
+		bodyVar := `result`
+		if response.HasHeaders() {
+			bodyVar = `responseBody`
+		}
 		if response.Body.Is(spec.ResponseBodyString) {
-			w.Line(`  result, err := response.Text(resp)`)
+			w.Line(`  %s, err := response.Text(resp)`, bodyVar)
 			w.Line(`  if err != nil {`)
 			w.Line(`    return %s`, operationError(response.Operation, `err`))
 			w.Line(`  }`)
 		}
 		if response.Body.Is(spec.ResponseBodyJson) {
-			w.Line(`  var result %s`, g.Types.GoType(&response.Body.Type.Definition))
-			w.Line(`  err := response.Json(resp, &result)`)
+			w.Line(`  var %s %s`, bodyVar, g.Types.GoType(&response.Body.Type.Definition))
+			w.Line(`  err := response.Json(resp, &%s)`, bodyVar)
 			w.Line(`  if err != nil {`)
 			w.Line(`    return %s`, operationError(response.Operation, `err`))
 			w.Line(`  }`)
 		}
+		if response.Body.Is(spec.ResponseBodyBinary) {
+			w.Line(`  %s := response.Binary(resp, cancel)`, bodyVar)
+		}
+		if response.Body.Is(spec.ResponseBodyNdjson) {
+			w.Line(`  %s := response.NewNdjsonStream[%s](resp, cancel)`, bodyVar, g.Types.GoType(&response.Body.Type.Definition))
+		}
+		if response.Body.Is(spec.ResponseBodySse) {
+			w.Line(`  %s := response.NewSseStream[%s](resp, cancel)`, bodyVar, g.Types.GoType(&response.Body.Type.Definition))
+		}
+		if response.HasHeaders() {
+			g.readResponseHeaders(w.Indented(), &response, bodyVar, `result`)
+		}
 
+		if response.Body.IsBinary() || response.Body.IsStream() {
+			w.Line(`  closeWithBody = true`)
+		}
 		if response.IsSuccess() {
 			w.Line(`  return %s`, resultSuccess(&response, `result`))
 		} else {
@@ -250,15 +289,89 @@ func (g *NetHttpGenerator) processResponses(w *writer.Writer, operation *spec.Na
 	w.Line(`  }`)
 	w.EmptyLine()
 	w.Line(`  msg := fmt.Sprintf("Unexpected status code received: %s", resp.StatusCode)`, "%d")
-	w.Line(`  log.WithFields(%s).Error(msg)`, logFieldsName(operation))
+	w.Line(`  logging.Error(%s, msg)`, logFieldsName(operation))
 	w.Line(`  return %s`, operationError(operation, `errors.New(msg)`))
 	w.Line(`}`)
 }
 
+func (g *NetHttpGenerator) readResponseHeaders(w *writer.Writer, response *spec.OperationResponse, bodyVar, resultVar string) {
+	if response.Body.IsEmpty() {
+		w.Line(`%s := %s{}`, resultVar, responseHeadersTypeName(response))
+	} else {
+		w.Line(`%s := %s{Body: %s}`, resultVar, responseHeadersTypeName(response), bodyVar)
+	}
+	for _, header := range response.Headers {
+		fieldVar := fmt.Sprintf(`%s.%s`, resultVar, header.Name.PascalCase())
+		switch header.Type.Definition.Node {
+		case spec.NullableType:
+			w.Line(`if value := resp.Header.Get("%s"); value != "" {`, header.Name.Source)
+			g.parseHeaderValue(w.Indented(), response.Operation, header.Type.Definition.Child, `value`, `parsed`)
+			w.Line(`  %s = &parsed`, fieldVar)
+			w.Line(`}`)
+		case spec.ArrayType:
+			if header.Type.Definition.Child.Plain == spec.TypeString {
+				w.Line(`%s = resp.Header.Values("%s")`, fieldVar, header.Name.Source)
+				continue
+			}
+			w.Line(`for _, value := range resp.Header.Values("%s") {`, header.Name.Source)
+			g.parseHeaderValue(w.Indented(), response.Operation, header.Type.Definition.Child, `value`, `parsed`)
+			w.Line(`  %s = append(%s, parsed)`, fieldVar, fieldVar)
+			w.Line(`}`)
+		default:
+			value, canFail := g.headerValue(&header.Type.Definition, fmt.Sprintf(`resp.Header.Get("%s")`, header.Name.Source))
+			if canFail {
+				w.Line(`%s, err = %s`, fieldVar, value)
+				w.Line(`if err != nil {`)
+				w.Line(`  return %s`, operationError(response.Operation, `err`))
+				w.Line(`}`)
+			} else {
+				w.Line(`%s = %s`, fieldVar, value)
+			}
+		}
+	}
+}
+
+func (g *NetHttpGenerator) parseHeaderValue(w *writer.Writer, operation *spec.NamedOperation, typ *spec.TypeDef, valueVar, parsedVar string) {
+	value, canFail := g.headerValue(typ, valueVar)
+	if canFail {
+		w.Line(`%s, err := %s`, parsedVar, value)
+		w.Line(`if err != nil {`)
+		w.Line(`  return %s`, operationError(operation, `err`))
+		w.Line(`}`)
+	} else {
+		w.Line(`%s := %s`, parsedVar, value)
+	}
+}
+
+func (g *NetHttpGenerator) headerValue(typ *spec.TypeDef, valueVar string) (string, bool) {
+	if typ.Info.Model != nil && typ.Info.Model.IsEnum() {
+		return fmt.Sprintf(`%s(%s)`, g.Types.GoType(typ), valueVar), false
+	}
+	if typ.Plain == spec.TypeString {
+		return valueVar, false
+	}
+	return fmt.Sprintf(`params.Parse%s(%s)`, converterMethodNamePlain(typ), valueVar), true
+}
+
 func newResponse(response *spec.OperationResponse, body string) string {
+	if response.Body.IsEmpty() && !response.HasHeaders() {
+		return fmt.Sprintf(`%s{%s: &empty.Value}`, responseTypeName(response.Operation), response.Name.PascalCase())
+	}
+	if (response.Body.IsBinary() || response.Body.IsStream()) && !response.HasHeaders() {
+		return fmt.Sprintf(`%s{%s: %s}`, responseTypeName(response.Operation), response.Name.PascalCase(), body)
+	}
 	return fmt.Sprintf(`%s{%s: &%s}`, responseTypeName(response.Operation), response.Name.PascalCase(), body)
 }
 
+func operationReturnsBody(operation *spec.NamedOperation) bool {
+	for _, response := range operation.Responses.Success() {
+		if response.Body.IsBinary() || response.Body.IsStream() {
+			return true
+		}
+	}
+	return false
+}
+
 func clientTypeName() string {
 	return `Client`
 }
@@ -268,26 +381,56 @@ func logFieldsName(operation *spec.NamedOperation) string {
 }
 
 func responseStruct(w *writer.Writer, types *types.Types, operation *spec.NamedOperation) {
+	for _, response := range operation.Responses.Success() {
+		if response.HasHeaders() {
+			responseHeadersStruct(w, types, response)
+		}
+	}
 	if len(operation.Responses.Success()) > 1 {
 		w.Line(`type %s struct {`, responseTypeName(operation))
 		w.Indent()
 		for _, response := range operation.Responses.Success() {
-			w.LineAligned(`%s *%s`, response.Name.PascalCase(), types.ResponseBodyGoType(&response.Body))
+			if response.HasHeaders() {
+				w.LineAligned(`%s *%s`, response.Name.PascalCase(), responseHeadersTypeName(response))
+			} else {
+				w.LineAligned(`%s %s`, response.Name.PascalCase(), responseBodyGoTypeRef(types, &response.Body))
+			}
 		}
 		w.Unindent()
 		w.Line(`}`)
 	}
 }
 
+func responseHeadersStruct(w *writer.Writer, types *types.Types, response *spec.OperationResponse) {
+	w.Line(`type %s struct {`, responseHeadersTypeName(response))
+	w.Indent()
+	if !response.Body.IsEmpty() {
+		w.LineAligned(`Body %s`, responseBodyGoType(types, &response.Body))
+	}
+	for _, header := range response.Headers {
+		w.LineAligned(`%s %s`, header.Name.PascalCase(), types.GoType(&header.Type.Definition))
+	}
+	w.Unindent()
+	w.Line(`}`)
+}
+
 func responseTypeName(operation *spec.NamedOperation) string {
 	return fmt.Sprintf(`%sResponse`, operation.Name.PascalCase())
 }
 
-func (g *NetHttpGenerator) ResponseHelperFunctions() *generator.CodeFile {
+func responseHeadersTypeName(response *spec.OperationResponse) string {
+	return fmt.Sprintf(`%s%s`, response.Operation.Name.PascalCase(), response.Name.PascalCase())
+}
+
+func (g *NetHttpGenerator) ResponseHelperFunctions() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Response, `response.go`)
 	w.Lines(`
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 )
@@ -319,14 +462,156 @@ func Text(resp *http.Response) (string, error) {
 	}
 	return string(body), nil
 }
+
+type cancelingBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelingBody) Close() error {
+	defer body.cancel()
+	return body.ReadCloser.Close()
+}
+
+func Binary(resp *http.Response, cancel context.CancelFunc) io.ReadCloser {
+	return &cancelingBody{resp.Body, cancel}
+}
+
+const maxFrameSize = 1024 * 1024
+
+type StreamError struct {
+	Message string
+}
+
+func (e *StreamError) Error() string {
+	return "stream terminated by server: " + e.Message
+}
+
+type Stream[T any] struct {
+	body  io.ReadCloser
+	next  func() (frame []byte, failed bool, err error)
+	value T
+	err   error
+	done  bool
+}
+
+// ndjsonErrorFrame returns the error of the {"$error":{"message":"..."}} line that terminates a failed ndjson stream.
+func ndjsonErrorFrame(line []byte) []byte {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(line, &fields) != nil || len(fields) != 1 {
+		return nil
+	}
+	return fields["$error"]
+}
+
+func NewNdjsonStream[T any](resp *http.Response, cancel context.CancelFunc) *Stream[T] {
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFrameSize)
+	next := func() ([]byte, bool, error) {
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) > 0 {
+				if errorFrame := ndjsonErrorFrame(line); errorFrame != nil {
+					return errorFrame, true, nil
+				}
+				return line, false, nil
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, false, err
+		}
+		return nil, false, io.EOF
+	}
+	return &Stream[T]{body: Binary(resp, cancel), next: next}
+}
+
+func NewSseStream[T any](resp *http.Response, cancel context.CancelFunc) *Stream[T] {
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFrameSize)
+	next := func() ([]byte, bool, error) {
+		data := []byte{}
+		hasData := false
+		failed := false
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(line) == 0 {
+				if hasData {
+					return data, failed, nil
+				}
+				failed = false
+				continue
+			}
+			if bytes.HasPrefix(line, []byte("event:")) {
+				failed = string(bytes.TrimSpace(line[len("event:"):])) == "error"
+			}
+			if bytes.HasPrefix(line, []byte("data:")) {
+				if hasData {
+					data = append(data, '\n')
+				}
+				data = append(data, bytes.TrimPrefix(line[len("data:"):], []byte(" "))...)
+				hasData = true
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, false, err
+		}
+		if hasData {
+			return data, failed, nil
+		}
+		return nil, false, io.EOF
+	}
+	return &Stream[T]{body: Binary(resp, cancel), next: next}
+}
+
+func (stream *Stream[T]) Next() bool {
+	if stream.done {
+		return false
+	}
+	frame, failed, err := stream.next()
+	if err == nil && failed {
+		streamErr := &StreamError{}
+		err = json.Unmarshal(frame, streamErr)
+		if err == nil {
+			err = streamErr
+		}
+	}
+	if err == nil {
+		var value T
+		err = json.Unmarshal(frame, &value)
+		if err == nil {
+			stream.value = value
+			return true
+		}
+	}
+	if err != io.EOF {
+		stream.err = err
+	}
+	stream.Close()
+	return false
+}
+
+func (stream *Stream[T]) Value() T {
+	return stream.value
+}
+
+func (stream *Stream[T]) Err() error {
+	return stream.err
+}
+
+func (stream *Stream[T]) Close() error {
+	if stream.done {
+		return nil
+	}
+	stream.done = true
+	return stream.body.Close()
+}
 `)
 	return w.ToCodeFile()
 }
 
-func (g *NetHttpGenerator) ErrorsHandler(errors spec.ErrorResponses) *generator.CodeFile {
+func (g *NetHttpGenerator) ErrorsHandler(errors spec.ErrorResponses) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.HttpErrors, `errors_handler.go`)
 
-	w.Imports.Add("net/http")
 	w.Imports.Module(g.Modules.HttpErrorsModels)
 	w.Imports.Module(g.Modules.Response)
 
@@ -0,0 +1,245 @@
+package client
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+func (g *Generator) CallOptions() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Options, `call_options.go`)
+	w.Lines(`
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type CallOptions struct {
+	Header  http.Header
+	Query   url.Values
+	Timeout time.Duration
+}
+
+type CallOption func(options *CallOptions)
+
+func WithHeader(key, value string) CallOption {
+	return func(options *CallOptions) {
+		options.Header.Add(key, value)
+	}
+}
+
+func WithQuery(key, value string) CallOption {
+	return func(options *CallOptions) {
+		options.Query.Add(key, value)
+	}
+}
+
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(options *CallOptions) {
+		options.Timeout = timeout
+	}
+}
+
+func NewCallOptions(opts ...CallOption) *CallOptions {
+	options := &CallOptions{Header: http.Header{}, Query: url.Values{}}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+func (options *CallOptions) Context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if options.Timeout > 0 {
+		return context.WithTimeout(ctx, options.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+func (options *CallOptions) Apply(req *http.Request) {
+	for key, values := range options.Header {
+		req.Header[key] = values
+	}
+	if len(options.Query) > 0 {
+		query := req.URL.Query()
+		for key, values := range options.Query {
+			query[key] = values
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+}
+`)
+	return w.ToCodeFile()
+}
+
+func (g *Generator) ClientOptions() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Options, `client_options.go`)
+	w.Lines(`
+import (
+	"net/http"
+)
+
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func RequestInterceptor(intercept func(req *http.Request) error) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			err := intercept(req)
+			if err != nil {
+				return nil, err
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func ResponseInterceptor(intercept func(resp *http.Response) error) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			err = intercept(resp)
+			if err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			return resp, nil
+		})
+	}
+}
+
+type ClientOptions struct {
+	HttpClient  *http.Client
+	Transport   http.RoundTripper
+	Middlewares []Middleware
+	Header      http.Header
+	Credentials map[string]func(req *http.Request)
+}
+
+type ClientOption func(options *ClientOptions)
+
+func WithHttpClient(httpClient *http.Client) ClientOption {
+	return func(options *ClientOptions) {
+		options.HttpClient = httpClient
+	}
+}
+
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(options *ClientOptions) {
+		options.Transport = transport
+	}
+}
+
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(options *ClientOptions) {
+		options.Middlewares = append(options.Middlewares, middlewares...)
+	}
+}
+
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(options *ClientOptions) {
+		options.Header.Add(key, value)
+	}
+}
+
+func NewClientOptions(opts ...ClientOption) *ClientOptions {
+	options := &ClientOptions{Header: http.Header{}, Credentials: map[string]func(req *http.Request){}}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+func (options *ClientOptions) Client() *http.Client {
+	httpClient := http.Client{}
+	if options.HttpClient != nil {
+		httpClient = *options.HttpClient
+	}
+	transport := options.Transport
+	if transport == nil {
+		transport = httpClient.Transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for index := len(options.Middlewares) - 1; index >= 0; index-- {
+		transport = options.Middlewares[index](transport)
+	}
+	httpClient.Transport = transport
+	return &httpClient
+}
+
+func (options *ClientOptions) Apply(req *http.Request) {
+	for key, values := range options.Header {
+		if _, exists := req.Header[key]; !exists {
+			req.Header[key] = values
+		}
+	}
+}
+
+func (options *ClientOptions) Authenticate(req *http.Request, schemes ...string) {
+	for _, scheme := range schemes {
+		if credentials, ok := options.Credentials[scheme]; ok {
+			credentials(req)
+			return
+		}
+	}
+}
+`)
+	return w.ToCodeFile()
+}
+
+func (g *Generator) Credentials(schemes spec.SecuritySchemes) (*generator.CodeFile, error) {
+	if len(schemes) == 0 {
+		return nil, nil
+	}
+
+	w := writer.New(g.Modules.Options, `credentials.go`)
+	for index, scheme := range schemes {
+		if index > 0 {
+			w.EmptyLine()
+		}
+		switch scheme.Type {
+		case spec.SecurityBearer:
+			w.Line(`func WithAuth%s(token string) ClientOption {`, scheme.Name.PascalCase())
+			w.Line(`  return func(options *ClientOptions) {`)
+			w.Line(`    options.Credentials["%s"] = func(req *http.Request) {`, scheme.Name.Source)
+			w.Line(`      req.Header.Set("Authorization", "Bearer "+token)`)
+			w.Line(`    }`)
+			w.Line(`  }`)
+			w.Line(`}`)
+		case spec.SecurityBasic:
+			w.Line(`func WithAuth%s(username, password string) ClientOption {`, scheme.Name.PascalCase())
+			w.Line(`  return func(options *ClientOptions) {`)
+			w.Line(`    options.Credentials["%s"] = func(req *http.Request) {`, scheme.Name.Source)
+			w.Line(`      req.SetBasicAuth(username, password)`)
+			w.Line(`    }`)
+			w.Line(`  }`)
+			w.Line(`}`)
+		case spec.SecurityApiKey:
+			w.Line(`func WithAuth%s(apiKey string) ClientOption {`, scheme.Name.PascalCase())
+			w.Line(`  return func(options *ClientOptions) {`)
+			w.Line(`    options.Credentials["%s"] = func(req *http.Request) {`, scheme.Name.Source)
+			if *scheme.In == spec.ApiKeyInQuery {
+				w.Line(`      query := req.URL.Query()`)
+				w.Line(`      query.Set("%s", apiKey)`, *scheme.KeyName)
+				w.Line(`      req.URL.RawQuery = query.Encode()`)
+			} else {
+				w.Line(`      req.Header.Set("%s", apiKey)`, *scheme.KeyName)
+			}
+			w.Line(`    }`)
+			w.Line(`  }`)
+			w.Line(`}`)
+		}
+	}
+	return w.ToCodeFile()
+}
@@ -53,12 +53,14 @@ func converterMethodNamePlain(typ *spec.TypeDef) string {
 		return "Date"
 	case spec.TypeDateTime:
 		return "DateTime"
+	case spec.TypeFile:
+		return "File"
 	default:
 		panic(fmt.Sprintf("Unsupported string param type: %v", typ.Plain))
 	}
 }
 
-func (g *Generator) TypeConverter() *generator.CodeFile {
+func (g *Generator) TypeConverter() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Params, `convert_types.go`)
 	w.Lines(`
 import (
@@ -107,7 +109,57 @@ func DateTime(value civil.DateTime) string {
 	return w.ToCodeFile()
 }
 
-func (g *Generator) Params() *generator.CodeFile {
+func (g *Generator) TypeParser() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Params, `parse_types.go`)
+	w.Lines(`
+import (
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"strconv"
+)
+
+func ParseInt(value string) (int, error) {
+	return strconv.Atoi(value)
+}
+
+func ParseInt64(value string) (int64, error) {
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func ParseFloat32(value string) (float32, error) {
+	result, err := strconv.ParseFloat(value, 32)
+	return float32(result), err
+}
+
+func ParseFloat64(value string) (float64, error) {
+	return strconv.ParseFloat(value, 64)
+}
+
+func ParseDecimal(value string) (decimal.Decimal, error) {
+	return decimal.NewFromString(value)
+}
+
+func ParseBool(value string) (bool, error) {
+	return strconv.ParseBool(value)
+}
+
+func ParseUuid(value string) (uuid.UUID, error) {
+	return uuid.Parse(value)
+}
+
+func ParseDate(value string) (civil.Date, error) {
+	return civil.ParseDate(value)
+}
+
+func ParseDateTime(value string) (civil.DateTime, error) {
+	return civil.ParseDateTime(value)
+}
+`)
+	return w.ToCodeFile()
+}
+
+func (g *Generator) Params() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Params, `params.go`)
 	w.Lines(`
 import (
@@ -286,16 +338,50 @@ func (self *ParamsWriter) StringEnumArray(key string, values []interface{}) {
 	return w.ToCodeFile()
 }
 
-func (g *Generator) FormDataParams() *generator.CodeFile {
-	w := writer.New(g.Modules.Params, `form_data_params.go`)
+func (g *Generator) CookieParams() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Params, `cookie_params.go`)
 	w.Lines(`
+import (
+	"net/http"
+)
+
+type CookieParamsSetter interface {
+	AddCookie(cookie *http.Cookie)
+}
+
+func NewCookieParamsWriter(setter CookieParamsSetter) *ParamsWriter {
+	return NewParamsWriter(&cookieSetter{setter})
+}
+
+type cookieSetter struct {
+	CookieParamsSetter
+}
+
+func (self *cookieSetter) Add(key, value string) {
+	self.AddCookie(&http.Cookie{Name: key, Value: value})
+}
+`)
+	return w.ToCodeFile()
+}
+
+func (g *Generator) FormDataParams() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Params, `form_data_params.go`)
+	w.Template(
+		map[string]string{
+			`FilesPackage`: g.Modules.Files.Package,
+		}, `
 import (
 	"errors"
 	"fmt"
+	"io"
+	"net/textproto"
+	"strings"
+	"[[.FilesPackage]]"
 )
 
 type FormDataParamsSetter interface {
 	WriteField(key, value string) error
+	CreatePart(header textproto.MIMEHeader) (io.Writer, error)
 	Close() error
 }
 
@@ -321,6 +407,31 @@ func (self *bridgeSetter) Add(key, value string) {
 	}
 }
 
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"")
+
+func (self *FormDataParamsWriter) File(key string, value files.File) {
+	contentType := value.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf("form-data; name=\"%s\"; filename=\"%s\"", quoteEscaper.Replace(key), quoteEscaper.Replace(value.Name)))
+	header.Set("Content-Type", contentType)
+	part, err := self.CreatePart(header)
+	if err == nil {
+		_, err = io.Copy(part, value.Content)
+	}
+	if err != nil {
+		self.errors = append(self.errors, errors.New(fmt.Sprintf("failed to write file %s: %s ", key, err.Error())))
+	}
+}
+
+func (self *FormDataParamsWriter) FileNullable(key string, value *files.File) {
+	if value != nil {
+		self.File(key, *value)
+	}
+}
+
 func (self *FormDataParamsWriter) CloseWriter() error {
 	err := self.Close()
 	if err != nil {
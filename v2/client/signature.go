@@ -16,22 +16,43 @@ func operationSignature(types *types.Types, operation *spec.NamedOperation) stri
 	)
 }
 
+func singleEmptySuccess(operation *spec.NamedOperation) bool {
+	successResponses := operation.Responses.Success()
+	return len(successResponses) == 1 && successResponses[0].Body.Is(spec.ResponseBodyEmpty) && !successResponses[0].HasHeaders()
+}
+
 func operationReturn(types *types.Types, operation *spec.NamedOperation) string {
 	successResponses := operation.Responses.Success()
 	if len(successResponses) == 1 {
+		if successResponses[0].HasHeaders() {
+			return fmt.Sprintf(`(*%s, error)`, responseHeadersTypeName(successResponses[0]))
+		}
 		if successResponses[0].Body.Is(spec.ResponseBodyEmpty) {
 			return `error`
 		} else {
-			return fmt.Sprintf(`(*%s, error)`, types.GoType(&successResponses[0].Body.Type.Definition))
+			return fmt.Sprintf(`(%s, error)`, responseBodyGoTypeRef(types, &successResponses[0].Body))
 		}
 	} else {
 		return fmt.Sprintf(`(*%s, error)`, responseTypeName(operation))
 	}
 }
 
+func responseBodyGoType(types *types.Types, body *spec.ResponseBody) string {
+	if body.IsStream() {
+		return fmt.Sprintf(`*response.Stream[%s]`, types.GoType(&body.Type.Definition))
+	}
+	return types.ResponseBodyGoType(body)
+}
+
+func responseBodyGoTypeRef(types *types.Types, body *spec.ResponseBody) string {
+	if body.IsStream() {
+		return responseBodyGoType(types, body)
+	}
+	return types.ResponseBodyGoTypeRef(body)
+}
+
 func operationError(operation *spec.NamedOperation, errorVar string) string {
-	successResponses := operation.Responses.Success()
-	if len(successResponses) == 1 && successResponses[0].Body.Is(spec.ResponseBodyEmpty) {
+	if singleEmptySuccess(operation) {
 		return errorVar
 	} else {
 		return fmt.Sprintf(`nil, %s`, errorVar)
@@ -41,8 +62,10 @@ func operationError(operation *spec.NamedOperation, errorVar string) string {
 func resultSuccess(response *spec.OperationResponse, resultVar string) string {
 	successResponses := response.Operation.Responses.Success()
 	if len(successResponses) == 1 {
-		if successResponses[0].Body.Is(spec.ResponseBodyEmpty) {
+		if singleEmptySuccess(response.Operation) {
 			return `nil`
+		} else if (response.Body.IsBinary() || response.Body.IsStream()) && !response.HasHeaders() {
+			return fmt.Sprintf(`%s, nil`, resultVar)
 		} else {
 			return fmt.Sprintf(`&%s, nil`, resultVar)
 		}
@@ -57,8 +80,7 @@ func resultError(response *spec.OperationResponse, errorsModules module.Module,
 		errorBody = resultVar
 	}
 	result := fmt.Sprintf(`&%s{%s}`, errorsModules.Get(response.Name.PascalCase()), errorBody)
-	successResponses := response.Operation.Responses.Success()
-	if len(successResponses) == 1 && successResponses[0].Body.Is(spec.ResponseBodyEmpty) {
+	if singleEmptySuccess(response.Operation) {
 		return result
 	} else {
 		return fmt.Sprintf(`nil, %s`, result)
@@ -66,13 +88,16 @@ func resultError(response *spec.OperationResponse, errorsModules module.Module,
 }
 
 func operationParams(types *types.Types, operation *spec.NamedOperation) []string {
-	params := []string{}
+	params := []string{"ctx context.Context"}
 	if operation.BodyIs(spec.RequestBodyString) {
 		params = append(params, fmt.Sprintf("body %s", types.GoType(&operation.Body.Type.Definition)))
 	}
 	if operation.BodyIs(spec.RequestBodyJson) {
 		params = append(params, fmt.Sprintf("body *%s", types.GoType(&operation.Body.Type.Definition)))
 	}
+	if operation.BodyIs(spec.RequestBodyBinary) {
+		params = append(params, "body io.Reader")
+	}
 	if operation.BodyIs(spec.RequestBodyFormData) {
 		for _, param := range operation.Body.FormData {
 			params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
@@ -89,8 +114,12 @@ func operationParams(types *types.Types, operation *spec.NamedOperation) []strin
 	for _, param := range operation.HeaderParams {
 		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
 	}
+	for _, param := range operation.CookieParams {
+		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
+	}
 	for _, param := range operation.Endpoint.UrlParams {
 		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
 	}
+	params = append(params, "opts ...options.CallOption")
 	return params
 }
@@ -6,7 +6,7 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
-func GenerateEmpty(emptyModule module.Module) *generator.CodeFile {
+func GenerateEmpty(emptyModule module.Module) (*generator.CodeFile, error) {
 	w := writer.New(emptyModule, `empty.go`)
 	w.Lines(`
 type Type struct{}
@@ -0,0 +1,23 @@
+package files
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/module"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+func GenerateFiles(filesModule module.Module) (*generator.CodeFile, error) {
+	w := writer.New(filesModule, `files.go`)
+	w.Lines(`
+import (
+	"io"
+)
+
+type File struct {
+	Name        string
+	ContentType string
+	Content     io.Reader
+}
+`)
+	return w.ToCodeFile()
+}
@@ -3,7 +3,9 @@ package generators
 import (
 	"github.com/specgen-io/specgen-golang/v2/client"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/openapi"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/logging"
 	"github.com/specgen-io/specgen-golang/v2/models"
 	"github.com/specgen-io/specgen-golang/v2/service"
 )
@@ -20,7 +22,7 @@ var Models = generator.Generator{
 		{Arg: generator.ArgModuleName, Required: true},
 		{Arg: generator.ArgGeneratePath, Required: true},
 	},
-	func(specification *spec.Spec, params generator.GeneratorArgsValues) *generator.Sources {
+	func(specification *spec.Spec, params generator.GeneratorArgsValues) (*generator.Sources, error) {
 		return models.GenerateModels(specification, params[generator.ArgJsonmode], params[generator.ArgModuleName], params[generator.ArgGeneratePath])
 	},
 }
@@ -32,15 +34,16 @@ var Client = generator.Generator{
 	[]generator.GeneratorArg{
 		{Arg: generator.ArgSpecFile, Required: true},
 		{Arg: generator.ArgJsonmode, Required: false, Values: JsonmodeGoValues},
+		{Arg: generator.ArgLogging, Required: false, Values: logging.Values, Default: logging.Logrus},
 		{Arg: generator.ArgModuleName, Required: true},
 		{Arg: generator.ArgGeneratePath, Required: true},
 	},
-	func(specification *spec.Spec, params generator.GeneratorArgsValues) *generator.Sources {
-		return client.GenerateClient(specification, params[generator.ArgJsonmode], params[generator.ArgModuleName], params[generator.ArgGeneratePath])
+	func(specification *spec.Spec, params generator.GeneratorArgsValues) (*generator.Sources, error) {
+		return client.GenerateClient(specification, params[generator.ArgJsonmode], params[generator.ArgLogging], params[generator.ArgModuleName], params[generator.ArgGeneratePath])
 	},
 }
 
-var ServerGoValues = []string{"vestigo", "httprouter", "chi"}
+var ServerGoValues = []string{"vestigo", "httprouter", "chi", "stdlib", "gin", "echo", "gorillamux"}
 
 var Service = generator.Generator{
 	"service-go",
@@ -50,13 +53,14 @@ var Service = generator.Generator{
 		{Arg: generator.ArgSpecFile, Required: true},
 		{Arg: generator.ArgJsonmode, Required: false, Values: JsonmodeGoValues},
 		{Arg: generator.ArgServer, Required: true, Values: ServerGoValues},
+		{Arg: generator.ArgLogging, Required: false, Values: logging.Values, Default: logging.Logrus},
 		{Arg: generator.ArgModuleName, Required: true},
 		{Arg: generator.ArgSwaggerPath, Required: false},
 		{Arg: generator.ArgGeneratePath, Required: true},
 		{Arg: generator.ArgServicesPath, Required: false},
 	},
-	func(specification *spec.Spec, params generator.GeneratorArgsValues) *generator.Sources {
-		return service.GenerateService(specification, params[generator.ArgJsonmode], params[generator.ArgServer], params[generator.ArgModuleName], params[generator.ArgSwaggerPath], params[generator.ArgGeneratePath], params[generator.ArgServicesPath])
+	func(specification *spec.Spec, params generator.GeneratorArgsValues) (*generator.Sources, error) {
+		return service.GenerateService(specification, params[generator.ArgJsonmode], params[generator.ArgServer], params[generator.ArgLogging], params[generator.ArgModuleName], params[generator.ArgSwaggerPath], params[generator.ArgGeneratePath], params[generator.ArgServicesPath])
 	},
 }
 
@@ -64,4 +68,5 @@ var All = []generator.Generator{
 	Models,
 	Client,
 	Service,
+	openapi.Openapi,
 }
@@ -4,6 +4,7 @@ go 1.18
 
 require (
 	github.com/pinzolo/casee v1.0.0
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/spf13/cobra v1.5.0
 	golang.org/x/exp v0.0.0-20220722155223-a9213eeb770e
 	gopkg.in/specgen-io/yaml.v3 v3.0.0-20220807035601-846c18c37062
@@ -0,0 +1,84 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+	"os"
+	"strings"
+)
+
+const FormatText = "text"
+const FormatJson = "json"
+
+var Formats = []string{FormatText, FormatJson}
+
+func AddCobraCommand(parent *cobra.Command) {
+	parent.AddCommand(diffCommand())
+}
+
+func diffCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "diff",
+		Short: "Detect breaking changes between two specification revisions",
+		Long: `Detect breaking changes between two specification revisions.
+
+Model changes are classified by the direction the model is used in by operations:
+a new required field or a tightened constraint is breaking for request models, while
+a new enum item, a new oneOf case or a loosened constraint is breaking for response models.
+Models used in both directions or not used by any operation are checked against both rules.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			oldFile, _ := cmd.Flags().GetString("old")
+			newFile, _ := cmd.Flags().GetString("new")
+			format, _ := cmd.Flags().GetString("format")
+			outFile, _ := cmd.Flags().GetString(generator.OutFile)
+			if !slices.Contains(Formats, format) {
+				console.ProblemLnF(`Argument format provided value "%s" is not among allowed: %s`, format, strings.Join(Formats, ", "))
+				os.Exit(1)
+			}
+
+			oldSpec := generator.ReadSpecFile(oldFile)
+			newSpec := generator.ReadSpecFile(newFile)
+			report := Compare(oldSpec, newSpec)
+
+			var output []byte
+			if format == FormatJson {
+				data, err := report.Json()
+				if err != nil {
+					console.ProblemLn("Failed to render report")
+					console.ProblemLn(err)
+					os.Exit(1)
+				}
+				output = append(data, '\n')
+			} else {
+				output = []byte(report.Text())
+			}
+
+			if outFile != "" {
+				err := os.WriteFile(outFile, output, 0644)
+				if err != nil {
+					console.ProblemLnF("Failed to write report: %s", outFile)
+					console.ProblemLn(err)
+					os.Exit(1)
+				}
+				console.PrintLn("Writing:", outFile)
+			} else {
+				fmt.Print(string(output))
+			}
+
+			if report.HasBreaking() {
+				console.ProblemLnF("Found %d breaking changes", report.Count(SeverityBreaking))
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().String("old", "", "path to old specification file")
+	command.Flags().String("new", "", "path to new specification file")
+	command.Flags().String("format", FormatText, "report format; allowed values: "+strings.Join(Formats, ", "))
+	command.Flags().String(generator.OutFile, "", generator.OutFileDescription)
+	command.MarkFlagRequired("old")
+	command.MarkFlagRequired("new")
+	return command
+}
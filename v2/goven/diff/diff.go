@@ -0,0 +1,471 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"gopkg.in/specgen-io/yaml.v3"
+	"strconv"
+)
+
+type Severity string
+
+const (
+	SeverityBreaking   Severity = "breaking"
+	SeverityCompatible Severity = "compatible"
+)
+
+type Location struct {
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+type Change struct {
+	Severity Severity  `json:"severity"`
+	Path     string    `json:"path"`
+	Message  string    `json:"message"`
+	Old      *Location `json:"old,omitempty"`
+	New      *Location `json:"new,omitempty"`
+}
+
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+func (report *Report) Count(severity Severity) int {
+	count := 0
+	for _, change := range report.Changes {
+		if change.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+func (report *Report) HasBreaking() bool {
+	return report.Count(SeverityBreaking) > 0
+}
+
+type comparer struct {
+	old       *spec.Spec
+	new       *spec.Spec
+	oldUsages modelUsages
+	newUsages modelUsages
+	report    *Report
+}
+
+// Compare reports changes between two revisions of the specification, model changes are classified by the direction
+// the model is used in: a change is breaking when it is breaking for any of requests or responses the model is used in.
+func Compare(old *spec.Spec, new *spec.Spec) *Report {
+	comparer := &comparer{old, new, findUsages(old), findUsages(new), &Report{[]Change{}}}
+	comparer.Spec()
+	return comparer.report
+}
+
+func location(specification *spec.Spec, node *yaml.Node) *Location {
+	if node == nil {
+		return nil
+	}
+	specLocation, file := specification.Locate(node)
+	return &Location{file, specLocation.Line, specLocation.Column}
+}
+
+func (c *comparer) add(severity Severity, path string, oldNode *yaml.Node, newNode *yaml.Node, format string, args ...interface{}) {
+	change := Change{severity, path, fmt.Sprintf(format, args...), location(c.old, oldNode), location(c.new, newNode)}
+	c.report.Changes = append(c.report.Changes, change)
+}
+
+func (c *comparer) breaking(path string, oldNode *yaml.Node, newNode *yaml.Node, format string, args ...interface{}) {
+	c.add(SeverityBreaking, path, oldNode, newNode, format, args...)
+}
+
+func (c *comparer) compatible(path string, oldNode *yaml.Node, newNode *yaml.Node, format string, args ...interface{}) {
+	c.add(SeverityCompatible, path, oldNode, newNode, format, args...)
+}
+
+// change reports the change as breaking when the model is used in any of breakingIn directions and as compatible otherwise.
+func (c *comparer) change(usage usage, breakingIn usage, path string, oldNode *yaml.Node, newNode *yaml.Node, format string, args ...interface{}) {
+	if usage&breakingIn != 0 {
+		c.breaking(path, oldNode, newNode, format, args...)
+	} else {
+		c.compatible(path, oldNode, newNode, format, args...)
+	}
+}
+
+func childPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func versionPath(version *spec.Version) string {
+	return version.Name.Source
+}
+
+func (c *comparer) Spec() {
+	for index := range c.old.Versions {
+		oldVersion := &c.old.Versions[index]
+		newVersion := findVersion(c.new, oldVersion.Name.Source)
+		if newVersion == nil {
+			c.breaking(versionPath(oldVersion), oldVersion.Name.Location, nil, "version %s removed", oldVersion.Name.Source)
+			continue
+		}
+		c.Version(oldVersion, newVersion)
+	}
+	for index := range c.new.Versions {
+		newVersion := &c.new.Versions[index]
+		if findVersion(c.old, newVersion.Name.Source) == nil {
+			c.compatible(versionPath(newVersion), nil, newVersion.Name.Location, "version %s added", newVersion.Name.Source)
+		}
+	}
+	if c.old.HttpErrors != nil && c.new.HttpErrors != nil {
+		c.Models("errors.models", c.old.HttpErrors.Models, c.new.HttpErrors.Models)
+	}
+}
+
+func findVersion(specification *spec.Spec, name string) *spec.Version {
+	for index := range specification.Versions {
+		if specification.Versions[index].Name.Source == name {
+			return &specification.Versions[index]
+		}
+	}
+	return nil
+}
+
+func (c *comparer) Version(old *spec.Version, new *spec.Version) {
+	c.Http(childPath(versionPath(old), "http"), &old.Http, &new.Http)
+	c.Models(childPath(versionPath(old), "models"), old.Models, new.Models)
+}
+
+func operationsMap(http *spec.Http) (map[string]*spec.NamedOperation, []string) {
+	operations := map[string]*spec.NamedOperation{}
+	keys := []string{}
+	for apiIndex := range http.Apis {
+		api := &http.Apis[apiIndex]
+		for index := range api.Operations {
+			operation := &api.Operations[index]
+			key := api.Name.Source + "." + operation.Name.Source
+			operations[key] = operation
+			keys = append(keys, key)
+		}
+	}
+	return operations, keys
+}
+
+func (c *comparer) Http(path string, old *spec.Http, new *spec.Http) {
+	oldOperations, oldKeys := operationsMap(old)
+	newOperations, newKeys := operationsMap(new)
+	for _, key := range oldKeys {
+		oldOperation := oldOperations[key]
+		newOperation, found := newOperations[key]
+		if !found {
+			c.breaking(childPath(path, key), oldOperation.Name.Location, nil, "operation %s %s removed", oldOperation.Endpoint.Method, oldOperation.Endpoint.Url)
+			continue
+		}
+		c.Operation(childPath(path, key), oldOperation, newOperation)
+	}
+	for _, key := range newKeys {
+		if _, found := oldOperations[key]; !found {
+			newOperation := newOperations[key]
+			c.compatible(childPath(path, key), nil, newOperation.Name.Location, "operation %s %s added", newOperation.Endpoint.Method, newOperation.Endpoint.Url)
+		}
+	}
+}
+
+func (c *comparer) Operation(path string, old *spec.NamedOperation, new *spec.NamedOperation) {
+	if old.Endpoint.Method != new.Endpoint.Method {
+		c.breaking(path, old.Location, new.Location, "method changed from %s to %s", old.Endpoint.Method, new.Endpoint.Method)
+	}
+	if old.Endpoint.Url != new.Endpoint.Url {
+		c.breaking(path, old.Location, new.Location, "url changed from %s to %s", old.Endpoint.Url, new.Endpoint.Url)
+	}
+	if !old.IsSecured() && new.IsSecured() {
+		c.breaking(path, old.Location, new.Location, "operation now requires authentication")
+	}
+	c.Params(childPath(path, "url"), "url parameter", spec.Params(old.Endpoint.UrlParams), spec.Params(new.Endpoint.UrlParams))
+	c.Params(childPath(path, "header"), "header parameter", spec.Params(old.HeaderParams), spec.Params(new.HeaderParams))
+	c.Params(childPath(path, "query"), "query parameter", spec.Params(old.QueryParams), spec.Params(new.QueryParams))
+	c.Params(childPath(path, "cookie"), "cookie parameter", spec.Params(old.CookieParams), spec.Params(new.CookieParams))
+	c.Body(childPath(path, "body"), old.Body, new.Body)
+	c.Responses(childPath(path, "response"), old.Responses, new.Responses)
+}
+
+func isRequired(definition *spec.DefinitionDefault) bool {
+	return !definition.Type.Definition.IsNullable() && definition.Default == nil
+}
+
+func nonNullableType(typ *spec.TypeDef) string {
+	if typ.IsNullable() {
+		return typ.Child.String()
+	}
+	return typ.String()
+}
+
+func findParam(params spec.Params, name string) *spec.NamedParam {
+	for index := range params {
+		if params[index].Name.Source == name {
+			return &params[index]
+		}
+	}
+	return nil
+}
+
+func (c *comparer) Params(path string, kind string, old spec.Params, new spec.Params) {
+	for index := range old {
+		oldParam := &old[index]
+		newParam := findParam(new, oldParam.Name.Source)
+		paramPath := childPath(path, oldParam.Name.Source)
+		if newParam == nil {
+			c.compatible(paramPath, oldParam.Name.Location, nil, "%s %s removed", kind, oldParam.Name.Source)
+			continue
+		}
+		oldType, newType := nonNullableType(&oldParam.Type.Definition), nonNullableType(&newParam.Type.Definition)
+		if oldType != newType {
+			c.breaking(paramPath, oldParam.Location, newParam.Location, "%s %s type changed from %s to %s", kind, oldParam.Name.Source, oldType, newType)
+		}
+		if !isRequired(&oldParam.DefinitionDefault) && isRequired(&newParam.DefinitionDefault) {
+			c.breaking(paramPath, oldParam.Location, newParam.Location, "%s %s became required", kind, oldParam.Name.Source)
+		}
+		c.Constraints(paramPath, kind+" "+oldParam.Name.Source, usageRequest, oldParam.Location, newParam.Location, oldParam.Constraints, newParam.Constraints)
+	}
+	for index := range new {
+		newParam := &new[index]
+		if findParam(old, newParam.Name.Source) == nil {
+			paramPath := childPath(path, newParam.Name.Source)
+			if isRequired(&newParam.DefinitionDefault) {
+				c.breaking(paramPath, nil, newParam.Location, "required %s %s added", kind, newParam.Name.Source)
+			} else {
+				c.compatible(paramPath, nil, newParam.Location, "optional %s %s added", kind, newParam.Name.Source)
+			}
+		}
+	}
+}
+
+// Constraints reports tightened constraints as breaking for requests and loosened ones as breaking for responses.
+func (c *comparer) Constraints(path string, subject string, usage usage, oldNode *yaml.Node, newNode *yaml.Node, old spec.Constraints, new spec.Constraints) {
+	c.bound(path, subject, usage, oldNode, newNode, spec.ConstraintMin, old.Min(), new.Min(), 1)
+	c.bound(path, subject, usage, oldNode, newNode, spec.ConstraintMax, old.Max(), new.Max(), -1)
+	oldPattern, newPattern := old.Pattern(), new.Pattern()
+	switch {
+	case oldPattern == nil && newPattern != nil:
+		c.change(usage, usageRequest, path, oldNode, newNode, "%s pattern constraint added: %s", subject, *newPattern)
+	case oldPattern != nil && newPattern == nil:
+		c.change(usage, usageResponse, path, oldNode, newNode, "%s pattern constraint removed", subject)
+	case oldPattern != nil && *oldPattern != *newPattern:
+		c.breaking(path, oldNode, newNode, "%s pattern constraint changed from %s to %s", subject, *oldPattern, *newPattern)
+	}
+}
+
+// bound compares min or max constraint, tighter direction is 1 when a greater value is tighter and -1 otherwise.
+func (c *comparer) bound(path string, subject string, usage usage, oldNode *yaml.Node, newNode *yaml.Node, name string, old *string, new *string, tighter float64) {
+	switch {
+	case old == nil && new == nil:
+	case old == nil:
+		c.change(usage, usageRequest, path, oldNode, newNode, "%s %s constraint added: %s", subject, name, *new)
+	case new == nil:
+		c.change(usage, usageResponse, path, oldNode, newNode, "%s %s constraint removed", subject, name)
+	default:
+		oldValue, oldErr := strconv.ParseFloat(*old, 64)
+		newValue, newErr := strconv.ParseFloat(*new, 64)
+		if oldErr != nil || newErr != nil || oldValue == newValue {
+			return
+		}
+		if (newValue-oldValue)*tighter > 0 {
+			c.change(usage, usageRequest, path, oldNode, newNode, "%s %s constraint tightened from %s to %s", subject, name, *old, *new)
+		} else {
+			c.change(usage, usageResponse, path, oldNode, newNode, "%s %s constraint loosened from %s to %s", subject, name, *old, *new)
+		}
+	}
+}
+
+func bodyString(body *spec.RequestBody) string {
+	switch body.Kind() {
+	case spec.RequestBodyFormData, spec.RequestBodyFormUrlEncoded:
+		return string(body.Kind())
+	default:
+		return body.Type.Definition.String()
+	}
+}
+
+func (c *comparer) Body(path string, old *spec.RequestBody, new *spec.RequestBody) {
+	if old.Kind() != new.Kind() || bodyString(old) != bodyString(new) {
+		c.breaking(path, old.Location, new.Location, "request body changed from %s to %s", bodyString(old), bodyString(new))
+		return
+	}
+	c.Params(path, "form parameter", spec.Params(old.FormData), spec.Params(new.FormData))
+	c.Params(path, "form parameter", spec.Params(old.FormUrlEncoded), spec.Params(new.FormUrlEncoded))
+}
+
+func (c *comparer) Responses(path string, old spec.OperationResponses, new spec.OperationResponses) {
+	for _, oldResponse := range old {
+		newResponse := new.Get(oldResponse.Name.Source)
+		responsePath := childPath(path, oldResponse.Name.Source)
+		if newResponse == nil {
+			c.breaking(responsePath, oldResponse.Name.Location, nil, "response %s removed", oldResponse.Name.Source)
+			continue
+		}
+		oldBody, newBody := oldResponse.Body.String(), newResponse.Body.String()
+		if oldBody != newBody {
+			c.breaking(responsePath, oldResponse.Body.Location, newResponse.Body.Location, "response %s body changed from %s to %s", oldResponse.Name.Source, oldBody, newBody)
+		}
+	}
+	for _, newResponse := range new {
+		if old.Get(newResponse.Name.Source) == nil {
+			c.compatible(childPath(path, newResponse.Name.Source), nil, newResponse.Name.Location, "response %s added", newResponse.Name.Source)
+		}
+	}
+}
+
+func findModel(models spec.Models, name string) *spec.NamedModel {
+	for index := range models {
+		if models[index].Name.Source == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+func modelKind(model *spec.NamedModel) string {
+	switch {
+	case model.IsObject():
+		return "object"
+	case model.IsEnum():
+		return "enum"
+	case model.IsOneOf():
+		return "oneOf"
+	default:
+		return "unknown"
+	}
+}
+
+func (c *comparer) Models(path string, old spec.Models, new spec.Models) {
+	for index := range old {
+		oldModel := &old[index]
+		newModel := findModel(new, oldModel.Name.Source)
+		modelPath := childPath(path, oldModel.Name.Source)
+		if newModel == nil {
+			c.breaking(modelPath, oldModel.Name.Location, nil, "model %s removed", oldModel.Name.Source)
+			continue
+		}
+		if modelKind(oldModel) != modelKind(newModel) {
+			c.breaking(modelPath, oldModel.Location, newModel.Location, "model %s changed from %s to %s", oldModel.Name.Source, modelKind(oldModel), modelKind(newModel))
+			continue
+		}
+		usage := c.modelUsage(oldModel, newModel)
+		switch {
+		case oldModel.IsObject():
+			c.Object(modelPath, usage, oldModel, newModel)
+		case oldModel.IsEnum():
+			c.Enum(modelPath, usage, oldModel, newModel)
+		case oldModel.IsOneOf():
+			c.OneOf(modelPath, usage, oldModel, newModel)
+		}
+	}
+	for index := range new {
+		newModel := &new[index]
+		if findModel(old, newModel.Name.Source) == nil {
+			c.compatible(childPath(path, newModel.Name.Source), nil, newModel.Name.Location, "model %s added", newModel.Name.Source)
+		}
+	}
+}
+
+func findDefinition(definitions spec.NamedDefinitions, name string) *spec.NamedDefinition {
+	for index := range definitions {
+		if definitions[index].Name.Source == name {
+			return &definitions[index]
+		}
+	}
+	return nil
+}
+
+func (c *comparer) Object(path string, usage usage, old *spec.NamedModel, new *spec.NamedModel) {
+	for index := range old.Object.Fields {
+		oldField := &old.Object.Fields[index]
+		newField := findDefinition(new.Object.Fields, oldField.Name.Source)
+		fieldPath := childPath(path, oldField.Name.Source)
+		if newField == nil {
+			c.breaking(fieldPath, oldField.Name.Location, nil, "field %s removed", oldField.Name.Source)
+			continue
+		}
+		oldType, newType := oldField.Type.Definition.String(), newField.Type.Definition.String()
+		if oldType != newType {
+			c.breaking(fieldPath, oldField.Location, newField.Location, "field %s type changed from %s to %s", oldField.Name.Source, oldType, newType)
+		}
+		c.Constraints(fieldPath, "field "+oldField.Name.Source, usage, oldField.Location, newField.Location, oldField.Constraints, newField.Constraints)
+	}
+	for index := range new.Object.Fields {
+		newField := &new.Object.Fields[index]
+		if findDefinition(old.Object.Fields, newField.Name.Source) == nil {
+			fieldPath := childPath(path, newField.Name.Source)
+			if newField.Type.Definition.IsNullable() {
+				c.compatible(fieldPath, nil, newField.Location, "optional field %s added", newField.Name.Source)
+			} else {
+				c.change(usage, usageRequest, fieldPath, nil, newField.Location, "required field %s added", newField.Name.Source)
+			}
+		}
+	}
+}
+
+func findEnumItem(items spec.EnumItems, name string) *spec.NamedEnumItem {
+	for index := range items {
+		if items[index].Name.Source == name {
+			return &items[index]
+		}
+	}
+	return nil
+}
+
+func (c *comparer) Enum(path string, usage usage, old *spec.NamedModel, new *spec.NamedModel) {
+	for index := range old.Enum.Items {
+		oldItem := &old.Enum.Items[index]
+		newItem := findEnumItem(new.Enum.Items, oldItem.Name.Source)
+		itemPath := childPath(path, oldItem.Name.Source)
+		if newItem == nil {
+			c.change(usage, usageRequest, itemPath, oldItem.Name.Location, nil, "enum item %s removed", oldItem.Name.Source)
+			continue
+		}
+		if oldItem.Value != newItem.Value {
+			c.breaking(itemPath, oldItem.Name.Location, newItem.Name.Location, "enum item %s value changed from %s to %s", oldItem.Name.Source, oldItem.Value, newItem.Value)
+		}
+	}
+	for index := range new.Enum.Items {
+		newItem := &new.Enum.Items[index]
+		if findEnumItem(old.Enum.Items, newItem.Name.Source) == nil {
+			c.change(usage, usageResponse, childPath(path, newItem.Name.Source), nil, newItem.Name.Location, "enum item %s added", newItem.Name.Source)
+		}
+	}
+}
+
+func discriminatorString(discriminator *string) string {
+	if discriminator == nil {
+		return "none"
+	}
+	return *discriminator
+}
+
+func (c *comparer) OneOf(path string, usage usage, old *spec.NamedModel, new *spec.NamedModel) {
+	oldDiscriminator, newDiscriminator := discriminatorString(old.OneOf.Discriminator), discriminatorString(new.OneOf.Discriminator)
+	if oldDiscriminator != newDiscriminator {
+		c.breaking(path, old.Location, new.Location, "oneOf discriminator changed from %s to %s", oldDiscriminator, newDiscriminator)
+	}
+	for index := range old.OneOf.Items {
+		oldItem := &old.OneOf.Items[index]
+		newItem := findDefinition(new.OneOf.Items, oldItem.Name.Source)
+		itemPath := childPath(path, oldItem.Name.Source)
+		if newItem == nil {
+			c.change(usage, usageRequest, itemPath, oldItem.Name.Location, nil, "oneOf case %s removed", oldItem.Name.Source)
+			continue
+		}
+		oldType, newType := oldItem.Type.Definition.String(), newItem.Type.Definition.String()
+		if oldType != newType {
+			c.breaking(itemPath, oldItem.Location, newItem.Location, "oneOf case %s type changed from %s to %s", oldItem.Name.Source, oldType, newType)
+		}
+	}
+	for index := range new.OneOf.Items {
+		newItem := &new.OneOf.Items[index]
+		if findDefinition(old.OneOf.Items, newItem.Name.Source) == nil {
+			c.change(usage, usageResponse, childPath(path, newItem.Name.Source), nil, newItem.Location, "oneOf case %s added", newItem.Name.Source)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package diff
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+const specMeta = `
+spec: 2.1
+name: testing
+version: 1
+`
+
+const specMetaLines = 4
+
+func readSpec(t *testing.T, content string) *spec.Spec {
+	specification, _, err := spec.ReadSpec([]byte(specMeta + content))
+	assert.NilError(t, err)
+	return specification
+}
+
+func changesStrings(report *Report) []string {
+	changes := []string{}
+	for _, change := range report.Changes {
+		changes = append(changes, string(change.Severity)+" "+change.Path+": "+change.Message)
+	}
+	return changes
+}
+
+const oldSpec = `
+http:
+  users:
+    get_user:
+      endpoint: GET /users/{id:uuid}
+      query:
+        verbose: bool?
+        fields: string[]
+      response:
+        ok: User
+        accepted: empty
+    delete_user:
+      endpoint: DELETE /users/{id:uuid}
+      response:
+        ok: empty
+models:
+  User:
+    object:
+      id: uuid
+      name: string
+      role: Role
+  Role:
+    enum:
+      - admin
+      - user
+  Event:
+    oneOf:
+      created: User
+      deleted: User
+`
+
+func Test_Compare_NoChanges(t *testing.T) {
+	report := Compare(readSpec(t, oldSpec), readSpec(t, oldSpec))
+	assert.Equal(t, len(report.Changes), 0)
+	assert.Equal(t, report.HasBreaking(), false)
+}
+
+func Test_Compare_Breaking(t *testing.T) {
+	newSpec := `
+http:
+  users:
+    get_user:
+      endpoint: POST /users/{id:string}
+      query:
+        verbose: bool
+        fields: string[]
+        limit: int
+      response:
+        ok: User
+models:
+  User:
+    object:
+      id: uuid
+      name: int
+      age: int
+  Role:
+    enum:
+      - admin
+  Event:
+    oneOf:
+      created: User
+`
+	report := Compare(readSpec(t, oldSpec), readSpec(t, newSpec))
+	assert.DeepEqual(t, changesStrings(report), []string{
+		"breaking http.users.get_user: method changed from GET to POST",
+		"breaking http.users.get_user.url.id: url parameter id type changed from uuid to string",
+		"breaking http.users.get_user.query.verbose: query parameter verbose became required",
+		"breaking http.users.get_user.query.limit: required query parameter limit added",
+		"breaking http.users.get_user.response.accepted: response accepted removed",
+		"breaking http.users.delete_user: operation DELETE /users/{id} removed",
+		"breaking models.User.name: field name type changed from string to int",
+		"breaking models.User.role: field role removed",
+		"compatible models.User.age: required field age added",
+		"compatible models.Role.user: enum item user removed",
+		"breaking models.Event.deleted: oneOf case deleted removed",
+	})
+	assert.Equal(t, report.HasBreaking(), true)
+}
+
+func Test_Compare_Compatible(t *testing.T) {
+	newSpec := strings.Replace(oldSpec, `
+        fields: string[]
+`, `
+        fields: string[]
+        page: int = 1
+`, 1) + `
+  Comment:
+    object:
+      text: string
+`
+	newSpec = strings.Replace(newSpec, "      - user\n", "", 1)
+	newSpec = strings.Replace(newSpec, `      name: string
+`, `      name: string
+      email: string?
+`, 1)
+	report := Compare(readSpec(t, oldSpec), readSpec(t, newSpec))
+	assert.DeepEqual(t, changesStrings(report), []string{
+		"compatible http.users.get_user.query.page: optional query parameter page added",
+		"compatible models.User.email: optional field email added",
+		"compatible models.Role.user: enum item user removed",
+		"compatible models.Comment: model Comment added",
+	})
+	assert.Equal(t, report.HasBreaking(), false)
+}
+
+func Test_Compare_Constraints(t *testing.T) {
+	oldSpec := `
+http:
+  users:
+    find_users:
+      endpoint: GET /users
+      query:
+        name: string(min=1,max=64)?
+        limit: int(max=100)?
+      response:
+        ok: empty
+    create_user:
+      endpoint: POST /users
+      body: User
+      response:
+        ok: empty
+models:
+  User:
+    object:
+      login: string(min=3,pattern=^[a-z]+$)
+      age: int(min=0,max=150)
+`
+	newSpec := `
+http:
+  users:
+    find_users:
+      endpoint: GET /users
+      query:
+        name: string(min=1,max=32)?
+        limit: int(max=200)?
+      response:
+        ok: empty
+    create_user:
+      endpoint: POST /users
+      body: User
+      response:
+        ok: empty
+models:
+  User:
+    object:
+      login: string(min=3,pattern=^[a-z0-9]+$)
+      age: int(min=18)
+`
+	report := Compare(readSpec(t, oldSpec), readSpec(t, newSpec))
+	assert.DeepEqual(t, changesStrings(report), []string{
+		"breaking http.users.find_users.query.name: query parameter name max constraint tightened from 64 to 32",
+		"compatible http.users.find_users.query.limit: query parameter limit max constraint loosened from 100 to 200",
+		"breaking models.User.login: field login pattern constraint changed from ^[a-z]+$ to ^[a-z0-9]+$",
+		"breaking models.User.age: field age min constraint tightened from 0 to 18",
+		"compatible models.User.age: field age max constraint removed",
+	})
+	assert.Equal(t, report.HasBreaking(), true)
+}
+
+func Test_Compare_ModelUsage(t *testing.T) {
+	oldSpec := `
+http:
+  users:
+    create_user:
+      endpoint: POST /users
+      body: NewUser
+      response:
+        ok: User
+models:
+  NewUser:
+    object:
+      login: string(min=3,max=32)
+      role: Role
+      address: Address
+  Role:
+    enum:
+      - admin
+      - user
+  User:
+    object:
+      login: string
+      age: int(max=150)
+      status: Status
+      address: Address
+  Status:
+    enum:
+      - active
+      - blocked
+  Address:
+    object:
+      city: string(max=64)
+  Event:
+    oneOf:
+      created: User
+`
+	newSpec := `
+http:
+  users:
+    create_user:
+      endpoint: POST /users
+      body: NewUser
+      response:
+        ok: User
+models:
+  NewUser:
+    object:
+      login: string(min=5,max=64)
+      role: Role
+      address: Address
+      email: string
+  Role:
+    enum:
+      - admin
+      - user
+      - guest
+  User:
+    object:
+      login: string
+      age: int(max=200)
+      status: Status
+      address: Address
+      email: string
+  Status:
+    enum:
+      - active
+      - deleted
+  Address:
+    object:
+      city: string(max=128)
+  Event:
+    oneOf:
+      created: User
+      updated: User
+`
+	report := Compare(readSpec(t, oldSpec), readSpec(t, newSpec))
+	assert.DeepEqual(t, changesStrings(report), []string{
+		"breaking models.NewUser.login: field login min constraint tightened from 3 to 5",
+		"compatible models.NewUser.login: field login max constraint loosened from 32 to 64",
+		"breaking models.NewUser.email: required field email added",
+		"compatible models.Role.guest: enum item guest added",
+		"breaking models.User.age: field age max constraint loosened from 150 to 200",
+		"compatible models.User.email: required field email added",
+		"compatible models.Status.blocked: enum item blocked removed",
+		"breaking models.Status.deleted: enum item deleted added",
+		"breaking models.Address.city: field city max constraint loosened from 64 to 128",
+		"breaking models.Event.updated: oneOf case updated added",
+	})
+}
+
+func Test_Compare_Locations(t *testing.T) {
+	newSpec := strings.Replace(oldSpec, "      name: string\n", "      name: int\n", 1)
+	report := Compare(readSpec(t, oldSpec), readSpec(t, newSpec))
+	assert.Equal(t, len(report.Changes), 1)
+	change := report.Changes[0]
+	assert.DeepEqual(t, change.Old, &Location{"", specMetaLines + 20, 13})
+	assert.DeepEqual(t, change.New, &Location{"", specMetaLines + 20, 13})
+}
+
+func Test_Report_Text(t *testing.T) {
+	report := &Report{[]Change{
+		{SeverityCompatible, "models.Comment", "model Comment added", nil, &Location{"new.yaml", 10, 3}},
+		{SeverityBreaking, "models.User.name", "field name removed", &Location{"old.yaml", 5, 7}, nil},
+	}}
+	expected := `
+breaking - models.User.name: field name removed [old: old.yaml (5, 7)]
+compatible - models.Comment: model Comment added [new: new.yaml (10, 3)]
+1 breaking, 1 compatible changes
+`
+	assert.Equal(t, report.Text(), strings.TrimLeft(expected, "\n"))
+}
@@ -0,0 +1,51 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func (location *Location) String() string {
+	if location.File != "" {
+		return fmt.Sprintf("%s (%d, %d)", location.File, location.Line, location.Column)
+	}
+	return fmt.Sprintf("(%d, %d)", location.Line, location.Column)
+}
+
+func (change *Change) String() string {
+	locations := []string{}
+	if change.Old != nil {
+		locations = append(locations, "old: "+change.Old.String())
+	}
+	if change.New != nil {
+		locations = append(locations, "new: "+change.New.String())
+	}
+	line := fmt.Sprintf("%s - %s: %s", change.Severity, change.Path, change.Message)
+	if len(locations) > 0 {
+		line += " [" + strings.Join(locations, ", ") + "]"
+	}
+	return line
+}
+
+func (report *Report) Text() string {
+	lines := []string{}
+	for _, severity := range []Severity{SeverityBreaking, SeverityCompatible} {
+		for _, change := range report.Changes {
+			if change.Severity == severity {
+				lines = append(lines, change.String())
+			}
+		}
+	}
+	lines = append(lines, fmt.Sprintf("%d breaking, %d compatible changes", report.Count(SeverityBreaking), report.Count(SeverityCompatible)))
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (report *Report) Json() ([]byte, error) {
+	data := struct {
+		Breaking   int      `json:"breaking"`
+		Compatible int      `json:"compatible"`
+		Changes    []Change `json:"changes"`
+	}{report.Count(SeverityBreaking), report.Count(SeverityCompatible), report.Changes}
+	return json.MarshalIndent(data, "", "  ")
+}
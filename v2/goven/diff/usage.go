@@ -0,0 +1,107 @@
+package diff
+
+import "github.com/specgen-io/specgen-golang/v2/goven/spec"
+
+// usage tells whether a model is sent by clients in requests, returned by services in responses or both.
+type usage int
+
+const (
+	usageRequest usage = 1 << iota
+	usageResponse
+	usageUnknown = usageRequest | usageResponse
+)
+
+type modelUsages map[*spec.NamedModel]usage
+
+func findUsages(specification *spec.Spec) modelUsages {
+	usages := modelUsages{}
+	for versionIndex := range specification.Versions {
+		http := &specification.Versions[versionIndex].Http
+		for apiIndex := range http.Apis {
+			api := &http.Apis[apiIndex]
+			for index := range api.Operations {
+				usages.operation(&api.Operations[index])
+			}
+		}
+	}
+	if specification.HttpErrors != nil {
+		for index := range specification.HttpErrors.Responses {
+			usages.response(&specification.HttpErrors.Responses[index].Response)
+		}
+	}
+	return usages
+}
+
+func (usages modelUsages) operation(operation *spec.NamedOperation) {
+	for _, params := range []spec.Params{
+		spec.Params(operation.Endpoint.UrlParams),
+		spec.Params(operation.HeaderParams),
+		spec.Params(operation.QueryParams),
+		spec.Params(operation.CookieParams),
+	} {
+		usages.params(params, usageRequest)
+	}
+	if operation.Body != nil {
+		if operation.Body.Type != nil {
+			usages.typ(&operation.Body.Type.Definition, usageRequest)
+		}
+		usages.params(spec.Params(operation.Body.FormData), usageRequest)
+		usages.params(spec.Params(operation.Body.FormUrlEncoded), usageRequest)
+	}
+	for index := range operation.Responses {
+		usages.response(&operation.Responses[index].Response)
+	}
+}
+
+func (usages modelUsages) response(response *spec.Response) {
+	if response.Body.Type != nil {
+		usages.typ(&response.Body.Type.Definition, usageResponse)
+	}
+	usages.params(spec.Params(response.Headers), usageResponse)
+}
+
+func (usages modelUsages) params(params spec.Params, usage usage) {
+	for index := range params {
+		usages.typ(&params[index].Type.Definition, usage)
+	}
+}
+
+func (usages modelUsages) typ(typ *spec.TypeDef, usage usage) {
+	if typ == nil {
+		return
+	}
+	if typ.Child != nil {
+		usages.typ(typ.Child, usage)
+	}
+	if typ.Info != nil && typ.Info.Model != nil {
+		usages.model(typ.Info.Model, usage)
+	}
+}
+
+func (usages modelUsages) model(model *spec.NamedModel, usage usage) {
+	if usages[model]&usage == usage {
+		return
+	}
+	usages[model] |= usage
+	switch {
+	case model.IsObject():
+		usages.definitions(model.Object.Fields, usage)
+	case model.IsOneOf():
+		usages.definitions(model.OneOf.Items, usage)
+	}
+}
+
+func (usages modelUsages) definitions(definitions spec.NamedDefinitions, usage usage) {
+	for index := range definitions {
+		usages.typ(&definitions[index].Type.Definition, usage)
+	}
+}
+
+// modelUsage combines usages of the model in both revisions, a model not used by any operation is treated as used in both directions.
+func (c *comparer) modelUsage(old *spec.NamedModel, new *spec.NamedModel) usage {
+	result := c.oldUsages[old] | c.newUsages[new]
+	if result == 0 {
+		return usageUnknown
+	}
+	return result
+}
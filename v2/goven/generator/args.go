@@ -48,6 +48,35 @@ const OutFile = "out-file"
 const OutFileTitle = "Output file path"
 const OutFileDescription = "path to output file"
 
+const OpenapiVersion = "openapi-version"
+const OpenapiVersionTitle = "OpenAPI version"
+const OpenapiVersionDescription = "version of generated OpenAPI specification"
+
+const Format = "format"
+const FormatTitle = "Output format"
+const FormatDescription = "format of output file"
+
+const Servers = "servers"
+const ServersTitle = "Server URLs"
+const ServersDescription = "comma separated list of server URLs"
+
+const SplitVersions = "split-versions"
+const SplitVersionsTitle = "Split versions"
+const SplitVersionsDescription = "generate separate document for each version"
+
+const Logging = "logging"
+const LoggingTitle = "Logging"
+const LoggingDescription = "logging library used by generated code"
+
+const ConfigFile = "config"
+const ConfigFileDescription = "path to configuration file listing generators to run"
+
+const WatchFlag = "watch"
+const WatchDescription = "watch specification files and regenerate code when they change"
+
+const Check = "check"
+const CheckDescription = "check that generated code is up to date without writing files, fail if it is not"
+
 var ArgSpecFile = Arg{SpecFile, SpecFileTitle, SpecFileDescription}
 var ArgOutFile = Arg{OutFile, OutFileTitle, OutFileDescription}
 var ArgModuleName = Arg{ModuleName, ModuleNameTitle, ModuleNameDescription}
@@ -60,3 +89,8 @@ var ArgJsonmode = Arg{Jsonmode, JsonmodeTitle, JsonmodeDescription}
 var ArgValidation = Arg{Validation, ValidationTitle, ValidationDescription}
 var ArgClient = Arg{Client, ClientTitle, ClientDescription}
 var ArgServer = Arg{Server, ServerTitle, ServerDescription}
+var ArgOpenapiVersion = Arg{OpenapiVersion, OpenapiVersionTitle, OpenapiVersionDescription}
+var ArgFormat = Arg{Format, FormatTitle, FormatDescription}
+var ArgServers = Arg{Servers, ServersTitle, ServersDescription}
+var ArgSplitVersions = Arg{SplitVersions, SplitVersionsTitle, SplitVersionsDescription}
+var ArgLogging = Arg{Logging, LoggingTitle, LoggingDescription}
@@ -1,13 +1,14 @@
 package generator
 
 import (
+	"fmt"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
-	"io/ioutil"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -15,6 +16,7 @@ func AddCobraCommands(parent *cobra.Command, generators []Generator) {
 	for index := range generators {
 		parent.AddCommand(generatorCommand(&generators[index]))
 	}
+	parent.AddCommand(runCommand(generators))
 }
 
 func generatorCommand(g *Generator) *cobra.Command {
@@ -24,7 +26,7 @@ func generatorCommand(g *Generator) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			params := GeneratorArgsValues{}
 			for _, arg := range g.Args {
-				value, err := cmd.Flags().GetString(arg.Name)
+				value, err := flagValue(cmd, &arg)
 				if err != nil {
 					console.ProblemLn(err)
 					os.Exit(1)
@@ -37,23 +39,52 @@ func generatorCommand(g *Generator) *cobra.Command {
 				}
 				params[arg.Arg] = value
 			}
-			specification := readSpecFile(params[ArgSpecFile])
-			sources := g.Generator(specification, params)
-			err := sources.Write(false, func(wrote bool, fullpath string) {
-				if wrote {
-					console.PrintLn("Writing:", fullpath)
-				} else {
-					console.PrintLn("Skipping:", fullpath)
+			check, err := cmd.Flags().GetBool(Check)
+			if err != nil {
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			watch, err := cmd.Flags().GetBool(WatchFlag)
+			if err != nil {
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			if watch {
+				if check {
+					console.ProblemLnF("Flags --%s and --%s can't be used together", Check, WatchFlag)
+					os.Exit(1)
 				}
-			})
+				Watch(func() []string {
+					specification, files, err := readSpecFile(params[ArgSpecFile])
+					if err != nil {
+						console.ProblemLnF("Failed to parse spec: %s", params[ArgSpecFile])
+						console.ProblemLn(err)
+						return files
+					}
+					_, err = generate(g, specification, params, "", false, printWrittenFile)
+					if err != nil {
+						console.ProblemLn(err)
+					}
+					return files
+				})
+				return
+			}
+			specification := ReadSpecFile(params[ArgSpecFile])
+			staleCount, err := generate(g, specification, params, "", check, printProcessedFile)
 			if err != nil {
-				console.ProblemLn("Failed to write source code")
 				console.ProblemLn(err)
 				os.Exit(1)
 			}
+			if check {
+				reportCheck(staleCount)
+			}
 		},
 	}
 	for _, arg := range g.Args {
+		if arg.Bool {
+			command.Flags().Bool(arg.Name, arg.Default == "true", arg.Description)
+			continue
+		}
 		description := arg.Description
 		if arg.Values != nil {
 			description += "; allowed values: " + strings.Join(arg.Values, ", ")
@@ -63,20 +94,100 @@ func generatorCommand(g *Generator) *cobra.Command {
 			command.MarkFlagRequired(arg.Name)
 		}
 	}
+	command.Flags().Bool(Check, false, CheckDescription)
+	command.Flags().Bool(WatchFlag, false, WatchDescription)
 	return command
 }
 
-func readSpecFile(specFile string) *spec.Spec {
-	console.PrintLnF("Reading spec file: %s", specFile)
-	data, err := ioutil.ReadFile(specFile)
+func flagValue(cmd *cobra.Command, arg *GeneratorArg) (string, error) {
+	if arg.Bool {
+		value, err := cmd.Flags().GetBool(arg.Name)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatBool(value), nil
+	}
+	return cmd.Flags().GetString(arg.Name)
+}
+
+func printProcessedFile(wrote bool, fullpath string) {
+	if wrote {
+		console.PrintLn("Writing:", fullpath)
+	} else {
+		console.PrintLn("Skipping:", fullpath)
+	}
+}
+
+func printWrittenFile(wrote bool, fullpath string) {
+	if wrote {
+		console.PrintLn("Writing:", fullpath)
+	}
+}
+
+// generate runs the generator and writes files to paths relative to dir, the current directory is used when dir is empty.
+func generate(g *Generator, specification *spec.Spec, params GeneratorArgsValues, dir string, check bool, processedFile ProcessedFileCallback) (int, error) {
+	sources, err := g.Generator(specification, params)
 	if err != nil {
-		console.ProblemLnF("Failed to read spec file: %s", specFile)
+		return 0, fmt.Errorf("failed to generate source code: %s", err.Error())
+	}
+	sources.InDir(dir)
+	if generatePath := params[ArgGeneratePath]; generatePath != "" {
+		err := sources.AddManifest(resolvePath(dir, generatePath), g.Name)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read generated files manifest: %s", err.Error())
+		}
+	}
+	if check {
+		return checkSources(sources)
+	}
+	err = sources.Write(false, processedFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write source code: %s", err.Error())
+	}
+	err = sources.Prune(func(fullpath string) {
+		console.PrintLn("Removing:", fullpath)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove stale source code: %s", err.Error())
+	}
+	return 0, nil
+}
+
+func checkSources(sources *Sources) (int, error) {
+	staleCount := 0
+	err := sources.Check(func(fullpath string, diff string) {
+		staleCount++
+		console.PrintLn("Out of date:", fullpath)
+		console.Print(diff)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to check source code: %s", err.Error())
+	}
+	return staleCount, nil
+}
+
+func reportCheck(staleCount int) {
+	if staleCount > 0 {
+		console.ProblemLnF("%d file(s) are out of date, rerun the generator to update them", staleCount)
+		os.Exit(1)
+	}
+	console.PrintLn("Generated code is up to date")
+}
+
+func ReadSpecFile(specFile string) *spec.Spec {
+	specification, _, err := readSpecFile(specFile)
+	if err != nil {
+		console.ProblemLnF("Failed to parse spec: %s", specFile)
 		console.ProblemLn(err)
 		os.Exit(1)
 	}
+	return specification
+}
 
+func readSpecFile(specFile string) (*spec.Spec, []string, error) {
+	console.PrintLnF("Reading spec file: %s", specFile)
 	console.PrintLn("Parsing spec")
-	specification, messages, err := spec.ReadSpec(data)
+	specification, files, messages, err := spec.ReadSpecFileWithImports(spec.SpecOptionsDefault, specFile)
 
 	if messages != nil {
 		sort.Sort(messages.Items)
@@ -89,11 +200,5 @@ func readSpecFile(specFile string) *spec.Spec {
 			}
 		}
 	}
-
-	if err != nil {
-		console.ProblemLnF("Failed to parse spec: %s", specFile)
-		console.ProblemLn(err)
-		os.Exit(1)
-	}
-	return specification
+	return specification, files, err
 }
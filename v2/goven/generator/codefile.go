@@ -1,9 +1,12 @@
 package generator
 
 import (
+	"bytes"
+	"github.com/pmezard/go-difflib/difflib"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type CodeFile struct {
@@ -23,6 +26,9 @@ func exists(path string) bool {
 func WriteFile(file *CodeFile, overwrite bool) (bool, error) {
 	if overwrite || !exists(file.Path) {
 		data := []byte(file.Content)
+		if current, err := ioutil.ReadFile(file.Path); err == nil && bytes.Equal(current, data) {
+			return false, nil
+		}
 
 		dir := filepath.Dir(file.Path)
 		_ = os.MkdirAll(dir, os.ModePerm)
@@ -55,3 +61,72 @@ func WriteFiles(files []CodeFile, overwrite bool, processedFile ProcessedFileCal
 	}
 	return nil
 }
+
+func CheckFile(file *CodeFile, checkContent bool) (string, error) {
+	fromFile := file.Path
+	currentLines := []string{}
+	if !exists(file.Path) {
+		fromFile = "/dev/null"
+	} else if !checkContent {
+		return "", nil
+	} else {
+		data, err := ioutil.ReadFile(file.Path)
+		if err != nil {
+			return "", err
+		}
+		if string(data) == file.Content {
+			return "", nil
+		}
+		currentLines = splitLines(string(data))
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        currentLines,
+		B:        splitLines(file.Content),
+		FromFile: fromFile,
+		ToFile:   file.Path,
+		Context:  3,
+	})
+}
+
+func RemovalDiff(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(data)),
+		B:        []string{},
+		FromFile: path,
+		ToFile:   "/dev/null",
+		Context:  3,
+	})
+}
+
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] += "\n"
+	}
+	return lines
+}
+
+type StaleFileCallback func(fullpath string, diff string)
+
+func CheckFiles(files []CodeFile, checkContent bool, staleFile StaleFileCallback) error {
+	for _, file := range files {
+		fullpath, err := filepath.Abs(file.Path)
+		if err != nil {
+			return err
+		}
+		diff, err := CheckFile(&file, checkContent)
+		if err != nil {
+			return err
+		}
+		if diff != "" && staleFile != nil {
+			staleFile(fullpath, diff)
+		}
+	}
+	return nil
+}
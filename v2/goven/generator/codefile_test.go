@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"gotest.tools/assert"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_CheckFile_UpToDate(t *testing.T) {
+	file := CodeFile{filepath.Join(t.TempDir(), "file.go"), "line1\nline2\n"}
+	_, err := WriteFile(&file, true)
+	assert.NilError(t, err)
+	diff, err := CheckFile(&file, true)
+	assert.NilError(t, err)
+	assert.Equal(t, diff, "")
+}
+
+func Test_CheckFile_Outdated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.go")
+	err := ioutil.WriteFile(path, []byte("line1\nold\n"), 0644)
+	assert.NilError(t, err)
+	file := CodeFile{path, "line1\nnew\n"}
+	diff, err := CheckFile(&file, true)
+	assert.NilError(t, err)
+	expected := `
+--- PATH
++++ PATH
+@@ -1,2 +1,2 @@
+ line1
+-old
++new
+`
+	assert.Equal(t, diff, strings.ReplaceAll(strings.TrimLeft(expected, "\n"), "PATH", path))
+	data, _ := ioutil.ReadFile(path)
+	assert.Equal(t, string(data), "line1\nold\n")
+}
+
+func Test_CheckFile_Missing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.go")
+	file := CodeFile{path, "line1\n"}
+	diff, err := CheckFile(&file, false)
+	assert.NilError(t, err)
+	expected := `
+--- /dev/null
++++ PATH
+@@ -0,0 +1 @@
++line1
+`
+	assert.Equal(t, diff, strings.ReplaceAll(strings.TrimLeft(expected, "\n"), "PATH", path))
+}
+
+func Test_CheckFile_Scaffolded_Changed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.go")
+	err := ioutil.WriteFile(path, []byte("edited\n"), 0644)
+	assert.NilError(t, err)
+	file := CodeFile{path, "line1\n"}
+	diff, err := CheckFile(&file, false)
+	assert.NilError(t, err)
+	assert.Equal(t, diff, "")
+}
+
+func Test_WriteFile_Unchanged(t *testing.T) {
+	file := CodeFile{filepath.Join(t.TempDir(), "file.go"), "line1\n"}
+	wrote, err := WriteFile(&file, true)
+	assert.NilError(t, err)
+	assert.Equal(t, wrote, true)
+	wrote, err = WriteFile(&file, true)
+	assert.NilError(t, err)
+	assert.Equal(t, wrote, false)
+	file.Content = "line2\n"
+	wrote, err = WriteFile(&file, true)
+	assert.NilError(t, err)
+	assert.Equal(t, wrote, true)
+}
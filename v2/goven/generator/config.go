@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"fmt"
+	"golang.org/x/exp/slices"
+	"gopkg.in/specgen-io/yaml.v3"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const ConfigFileName = "specgen.yaml"
+
+type ProjectConfig struct {
+	SpecFile   string            `yaml:"spec-file"`
+	Generators []GeneratorConfig `yaml:"generators"`
+}
+
+type GeneratorConfig struct {
+	Generator string            `yaml:"generator"`
+	Args      map[string]string `yaml:",inline"`
+}
+
+type GeneratorRun struct {
+	Generator *Generator
+	Params    GeneratorArgsValues
+}
+
+func ReadConfig(configFile string) (*ProjectConfig, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &ProjectConfig{}
+	err = yaml.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Generators) == 0 {
+		return nil, fmt.Errorf(`no generators listed in %s`, configFile)
+	}
+	return config, nil
+}
+
+func findGenerator(generators []Generator, name string) *Generator {
+	for index := range generators {
+		if generators[index].Name == name {
+			return &generators[index]
+		}
+	}
+	return nil
+}
+
+func checkArgValue(arg *GeneratorArg, value string) (string, error) {
+	if arg.Bool {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf(`argument %s provided value "%s" is not a boolean`, arg.Name, value)
+		}
+		return strconv.FormatBool(parsed), nil
+	}
+	if arg.Values != nil && !slices.Contains(arg.Values, value) {
+		return "", fmt.Errorf(`argument %s provided value "%s" is not among allowed: %s`, arg.Name, value, strings.Join(arg.Values, ", "))
+	}
+	return value, nil
+}
+
+func (config *ProjectConfig) Runs(generators []Generator) ([]GeneratorRun, error) {
+	runs := []GeneratorRun{}
+	for index, generatorConfig := range config.Generators {
+		g := findGenerator(generators, generatorConfig.Generator)
+		if g == nil {
+			names := []string{}
+			for _, generator := range generators {
+				names = append(names, generator.Name)
+			}
+			return nil, fmt.Errorf(`generator #%d: unknown generator "%s", available: %s`, index+1, generatorConfig.Generator, strings.Join(names, ", "))
+		}
+
+		args := map[string]string{}
+		for name, value := range generatorConfig.Args {
+			args[name] = value
+		}
+		if _, found := args[SpecFile]; !found && config.SpecFile != "" {
+			args[SpecFile] = config.SpecFile
+		}
+
+		known := map[string]bool{}
+		params := GeneratorArgsValues{}
+		for argIndex := range g.Args {
+			arg := &g.Args[argIndex]
+			known[arg.Name] = true
+			value, found := args[arg.Name]
+			if !found {
+				if arg.Required {
+					return nil, fmt.Errorf(`generator #%d %s: required argument %s is missing`, index+1, g.Name, arg.Name)
+				}
+				value = arg.Default
+			}
+			value, err := checkArgValue(arg, value)
+			if err != nil {
+				return nil, fmt.Errorf(`generator #%d %s: %s`, index+1, g.Name, err.Error())
+			}
+			params[arg.Arg] = value
+		}
+
+		unknown := []string{}
+		for name := range args {
+			if !known[name] {
+				unknown = append(unknown, name)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return nil, fmt.Errorf(`generator #%d %s: unknown arguments: %s`, index+1, g.Name, strings.Join(unknown, ", "))
+		}
+
+		runs = append(runs, GeneratorRun{g, params})
+	}
+	return runs, nil
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"gotest.tools/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testGenerators = []Generator{
+	{
+		"models-test",
+		"Test Models",
+		"Generate test models",
+		[]GeneratorArg{
+			{Arg: ArgSpecFile, Required: true},
+			{Arg: ArgJsonmode, Required: false, Values: []string{"strict", "nonstrict"}, Default: "strict"},
+			{Arg: ArgGeneratePath, Required: true},
+		},
+		func(specification *spec.Spec, params GeneratorArgsValues) (*Sources, error) { return NewSources(), nil },
+	},
+	{
+		"openapi-test",
+		"Test OpenAPI",
+		"Generate test OpenAPI",
+		[]GeneratorArg{
+			{Arg: ArgSpecFile, Required: true},
+			{Arg: ArgOutFile, Required: true},
+			{Arg: ArgSplitVersions, Required: false, Default: "false", Bool: true},
+		},
+		func(specification *spec.Spec, params GeneratorArgsValues) (*Sources, error) { return NewSources(), nil },
+	},
+}
+
+func readTestConfig(t *testing.T, content string) (*ProjectConfig, error) {
+	configFile := filepath.Join(t.TempDir(), ConfigFileName)
+	err := os.WriteFile(configFile, []byte(strings.TrimLeft(content, "\n")), 0644)
+	assert.NilError(t, err)
+	return ReadConfig(configFile)
+}
+
+func Test_Config_Runs(t *testing.T) {
+	config, err := readTestConfig(t, `
+spec-file: spec.yaml
+generators:
+  - generator: models-test
+    generate-path: ./models
+  - generator: openapi-test
+    spec-file: /specs/other.yaml
+    out-file: docs/openapi.yaml
+    split-versions: true
+`)
+	assert.NilError(t, err)
+	runs, err := config.Runs(testGenerators)
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(runs), 2)
+	assert.Equal(t, runs[0].Generator, &testGenerators[0])
+	assert.DeepEqual(t, runs[0].Params, GeneratorArgsValues{
+		ArgSpecFile:     "spec.yaml",
+		ArgJsonmode:     "strict",
+		ArgGeneratePath: "./models",
+	})
+	assert.Equal(t, runs[1].Generator, &testGenerators[1])
+	assert.DeepEqual(t, runs[1].Params, GeneratorArgsValues{
+		ArgSpecFile:      "/specs/other.yaml",
+		ArgOutFile:       "docs/openapi.yaml",
+		ArgSplitVersions: "true",
+	})
+}
+
+func Test_Config_NoGenerators(t *testing.T) {
+	_, err := readTestConfig(t, `
+spec-file: spec.yaml
+`)
+	assert.ErrorContains(t, err, "no generators listed")
+}
+
+func checkConfigError(t *testing.T, content string, expected string) {
+	config, err := readTestConfig(t, content)
+	assert.NilError(t, err)
+	_, err = config.Runs(testGenerators)
+	assert.Error(t, err, expected)
+}
+
+func Test_Config_UnknownGenerator(t *testing.T) {
+	checkConfigError(t, `
+generators:
+  - generator: unknown
+`, `generator #1: unknown generator "unknown", available: models-test, openapi-test`)
+}
+
+func Test_Config_MissingRequiredArg(t *testing.T) {
+	checkConfigError(t, `
+spec-file: spec.yaml
+generators:
+  - generator: models-test
+`, `generator #1 models-test: required argument generate-path is missing`)
+}
+
+func Test_Config_ArgValueNotAllowed(t *testing.T) {
+	checkConfigError(t, `
+spec-file: spec.yaml
+generators:
+  - generator: models-test
+    generate-path: ./models
+    jsonmode: loose
+`, `generator #1 models-test: argument jsonmode provided value "loose" is not among allowed: strict, nonstrict`)
+}
+
+func Test_Config_BoolArgValueNotBoolean(t *testing.T) {
+	checkConfigError(t, `
+spec-file: spec.yaml
+generators:
+  - generator: openapi-test
+    out-file: openapi.yaml
+    split-versions: sometimes
+`, `generator #1 openapi-test: argument split-versions provided value "sometimes" is not a boolean`)
+}
+
+func Test_Config_UnknownArgs(t *testing.T) {
+	checkConfigError(t, `
+spec-file: spec.yaml
+generators:
+  - generator: openapi-test
+    out-file: openapi.yaml
+    server: chi
+    generate-path: ./out
+`, `generator #1 openapi-test: unknown arguments: generate-path, server`)
+}
@@ -15,6 +15,7 @@ type GeneratorArg struct {
 	Values   []string
 	Required bool
 	Default  string
+	Bool     bool
 }
 
 type Generator struct {
@@ -27,4 +28,4 @@ type Generator struct {
 
 type GeneratorArgsValues map[Arg]string
 
-type GeneratorFunc func(specification *spec.Spec, params GeneratorArgsValues) *Sources
+type GeneratorFunc func(specification *spec.Spec, params GeneratorArgsValues) (*Sources, error)
@@ -0,0 +1,157 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestFileName is the prefix of manifest files, every generator keeps its own manifest named after the generator,
+// so generators sharing a generate path never treat files of each other as stale.
+const ManifestFileName = ".specgen-manifest"
+
+func manifestFileName(generatorName string) string {
+	return ManifestFileName + "." + generatorName
+}
+
+func isManifest(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), ManifestFileName+".")
+}
+
+const manifestHeader = "# Files generated by specgen, files listed here are removed once they are not generated anymore\n"
+
+func manifestFiles(generatePath string, files []CodeFile) []string {
+	paths := []string{}
+	for _, file := range files {
+		relPath, err := filepath.Rel(generatePath, file.Path)
+		if err != nil || !isInside(relPath) {
+			continue
+		}
+		paths = append(paths, filepath.ToSlash(relPath))
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func isInside(relPath string) bool {
+	return !filepath.IsAbs(relPath) && relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator))
+}
+
+func readManifest(manifestPath string) ([]string, error) {
+	if !exists(manifestPath) {
+		return []string{}, nil
+	}
+	data, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		paths = append(paths, line)
+	}
+	return paths, nil
+}
+
+// otherManifestsFiles returns files listed in manifests of other generators sharing the generate path.
+func otherManifestsFiles(generatePath string, manifestPath string) (map[string]bool, error) {
+	manifests, err := filepath.Glob(filepath.Join(generatePath, ManifestFileName+".*"))
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]bool{}
+	for _, otherPath := range manifests {
+		if otherPath == manifestPath {
+			continue
+		}
+		otherFiles, err := readManifest(otherPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, relPath := range otherFiles {
+			files[filepath.Join(generatePath, filepath.FromSlash(relPath))] = true
+		}
+	}
+	return files, nil
+}
+
+func (sources *Sources) AddManifest(generatePath string, generatorName string) error {
+	manifestPath := filepath.Join(generatePath, manifestFileName(generatorName))
+	previousFiles, err := readManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	ownedByOthers, err := otherManifestsFiles(generatePath, manifestPath)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]bool{}
+	for _, file := range append(sources.Generated, sources.Scaffolded...) {
+		current[filepath.Clean(file.Path)] = true
+	}
+	for _, relPath := range previousFiles {
+		relPath = filepath.FromSlash(relPath)
+		if !isInside(filepath.Clean(relPath)) {
+			continue
+		}
+		path := filepath.Join(generatePath, relPath)
+		if !current[path] && !ownedByOthers[path] && exists(path) {
+			sources.Stale = append(sources.Stale, StaleFile{path, generatePath})
+		}
+	}
+
+	content := manifestHeader
+	for _, relPath := range manifestFiles(generatePath, sources.Generated) {
+		content += relPath + "\n"
+	}
+	sources.AddGenerated(&CodeFile{manifestPath, content})
+	return nil
+}
+
+type StaleFile struct {
+	Path string
+	Root string
+}
+
+type RemovedFileCallback func(fullpath string)
+
+func RemoveFiles(files []StaleFile, removedFile RemovedFileCallback) error {
+	for _, file := range files {
+		fullpath, err := filepath.Abs(file.Path)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(file.Path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		removeEmptyDirs(filepath.Dir(file.Path), file.Root)
+		if removedFile != nil {
+			removedFile(fullpath)
+		}
+	}
+	return nil
+}
+
+func removeEmptyDirs(dir string, root string) {
+	root = filepath.Clean(root)
+	for dir = filepath.Clean(dir); dir != root; dir = filepath.Dir(dir) {
+		relPath, err := filepath.Rel(root, dir)
+		if err != nil || !isInside(relPath) {
+			return
+		}
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil || len(entries) > 0 {
+			return
+		}
+		if os.Remove(dir) != nil {
+			return
+		}
+	}
+}
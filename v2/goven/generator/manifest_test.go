@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"gotest.tools/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func generateFiles(t *testing.T, root string, generated []string, scaffolded []string) *Sources {
+	sources := NewSources()
+	for _, path := range generated {
+		sources.AddGenerated(&CodeFile{filepath.Join(root, path), "generated\n"})
+	}
+	for _, path := range scaffolded {
+		sources.AddScaffolded(&CodeFile{filepath.Join(root, path), "scaffolded\n"})
+	}
+	err := sources.AddManifest(root, "test")
+	assert.NilError(t, err)
+	err = sources.Write(false, nil)
+	assert.NilError(t, err)
+	return sources
+}
+
+func Test_Manifest_Content(t *testing.T) {
+	root := t.TempDir()
+	generateFiles(t, root, []string{"b/file.go", "a.go"}, []string{"main.go"})
+	data, err := ioutil.ReadFile(filepath.Join(root, manifestFileName("test")))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), manifestHeader+"a.go\nb/file.go\n")
+}
+
+func Test_Manifest_PrunesStaleFiles(t *testing.T) {
+	root := t.TempDir()
+	generateFiles(t, root, []string{"a.go", "old/file.go"}, []string{})
+
+	sources := generateFiles(t, root, []string{"a.go"}, []string{})
+	removed := []string{}
+	err := sources.Prune(func(fullpath string) { removed = append(removed, fullpath) })
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, removed, []string{filepath.Join(root, "old", "file.go")})
+	assert.Assert(t, exists(filepath.Join(root, "a.go")))
+	_, err = os.Stat(filepath.Join(root, "old"))
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func Test_Manifest_KeepsUserAndScaffoldedFiles(t *testing.T) {
+	root := t.TempDir()
+	generateFiles(t, root, []string{"a.go", "pkg/file.go"}, []string{})
+	userFile := filepath.Join(root, "pkg", "user.go")
+	err := ioutil.WriteFile(userFile, []byte("user\n"), 0644)
+	assert.NilError(t, err)
+
+	sources := generateFiles(t, root, []string{}, []string{"a.go"})
+	err = sources.Prune(nil)
+	assert.NilError(t, err)
+
+	assert.Assert(t, exists(filepath.Join(root, "a.go")))
+	assert.Assert(t, exists(userFile))
+	assert.Assert(t, !exists(filepath.Join(root, "pkg", "file.go")))
+}
+
+func Test_Manifest_CheckReportsStaleFiles(t *testing.T) {
+	root := t.TempDir()
+	generateFiles(t, root, []string{"a.go", "old.go"}, []string{})
+
+	sources := NewSources()
+	sources.AddGenerated(&CodeFile{filepath.Join(root, "a.go"), "generated\n"})
+	err := sources.AddManifest(root, "test")
+	assert.NilError(t, err)
+	stale := map[string]string{}
+	err = sources.Check(func(fullpath, diff string) { stale[fullpath] = diff })
+	assert.NilError(t, err)
+
+	oldPath := filepath.Join(root, "old.go")
+	manifestPath := filepath.Join(root, manifestFileName("test"))
+	assert.Equal(t, len(stale), 2)
+	assert.Equal(t, stale[oldPath], "--- "+oldPath+"\n+++ /dev/null\n@@ -1 +0,0 @@\n-generated\n")
+	assert.Assert(t, stale[manifestPath] != "")
+	assert.Assert(t, exists(oldPath))
+}
+
+func Test_Manifest_CheckIgnoresMissingManifest(t *testing.T) {
+	root := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(root, "a.go"), []byte("generated\n"), 0644)
+	assert.NilError(t, err)
+
+	sources := NewSources()
+	sources.AddGenerated(&CodeFile{filepath.Join(root, "a.go"), "generated\n"})
+	err = sources.AddManifest(root, "test")
+	assert.NilError(t, err)
+	stale := []string{}
+	err = sources.Check(func(fullpath, diff string) { stale = append(stale, fullpath) })
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(stale), 0)
+	assert.Assert(t, !exists(filepath.Join(root, manifestFileName("test"))))
+}
+
+func Test_Manifest_SharedGeneratePath(t *testing.T) {
+	root := t.TempDir()
+	generate := func(generatorName string, paths ...string) *Sources {
+		sources := NewSources()
+		for _, path := range paths {
+			sources.AddGenerated(&CodeFile{filepath.Join(root, path), "generated\n"})
+		}
+		err := sources.AddManifest(root, generatorName)
+		assert.NilError(t, err)
+		err = sources.Write(false, nil)
+		assert.NilError(t, err)
+		return sources
+	}
+	generate("models", "models/models.go")
+	generate("client", "client/client.go", "old/file.go")
+
+	models := generate("models", "models/models.go")
+	assert.Equal(t, len(models.Stale), 0)
+	client := generate("client", "client/client.go")
+	err := client.Prune(nil)
+	assert.NilError(t, err)
+
+	assert.Assert(t, exists(filepath.Join(root, "models", "models.go")))
+	assert.Assert(t, exists(filepath.Join(root, "client", "client.go")))
+	assert.Assert(t, !exists(filepath.Join(root, "old", "file.go")))
+}
@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+)
+
+func runCommand(generators []Generator) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "run",
+		Short: "Run generators listed in configuration file",
+		Run: func(cmd *cobra.Command, args []string) {
+			configFile, err := cmd.Flags().GetString(ConfigFile)
+			if err != nil {
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			check, err := cmd.Flags().GetBool(Check)
+			if err != nil {
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			watch, err := cmd.Flags().GetBool(WatchFlag)
+			if err != nil {
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			if watch {
+				if check {
+					console.ProblemLnF("Flags --%s and --%s can't be used together", Check, WatchFlag)
+					os.Exit(1)
+				}
+				WatchConfig(configFile, generators)
+				return
+			}
+			staleCount := RunConfig(configFile, generators, check)
+			if check {
+				reportCheck(staleCount)
+			}
+		},
+	}
+	command.Flags().String(ConfigFile, ConfigFileName, ConfigFileDescription)
+	command.Flags().Bool(Check, false, CheckDescription)
+	command.Flags().Bool(WatchFlag, false, WatchDescription)
+	return command
+}
+
+func RunConfig(configFile string, generators []Generator, check bool) int {
+	runs, err := readRuns(configFile, generators)
+	if err != nil {
+		console.ProblemLn(err)
+		os.Exit(1)
+	}
+	staleCount, _, err := runGenerators(runs, filepath.Dir(configFile), check, printProcessedFile)
+	if err != nil {
+		console.ProblemLn(err)
+		os.Exit(1)
+	}
+	return staleCount
+}
+
+func WatchConfig(configFile string, generators []Generator) {
+	Watch(func() []string {
+		files := []string{configFile}
+		runs, err := readRuns(configFile, generators)
+		if err != nil {
+			console.ProblemLn(err)
+			return files
+		}
+		_, specFiles, err := runGenerators(runs, filepath.Dir(configFile), false, printWrittenFile)
+		if err != nil {
+			console.ProblemLn(err)
+		}
+		return append(files, specFiles...)
+	})
+}
+
+func readRuns(configFile string, generators []Generator) ([]GeneratorRun, error) {
+	console.PrintLnF("Reading config file: %s", configFile)
+	config, err := ReadConfig(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %s", configFile, err.Error())
+	}
+	runs, err := config.Runs(generators)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %s", configFile, err.Error())
+	}
+	return runs, nil
+}
+
+// runGenerators runs generators with paths in their arguments resolved relative to the config file directory.
+func runGenerators(runs []GeneratorRun, configDir string, check bool, processedFile ProcessedFileCallback) (int, []string, error) {
+	specifications := map[string]*spec.Spec{}
+	files := []string{}
+	staleCount := 0
+	for _, run := range runs {
+		specFile := resolvePath(configDir, run.Params[ArgSpecFile])
+		specification, found := specifications[specFile]
+		if !found {
+			var specFiles []string
+			var err error
+			specification, specFiles, err = readSpecFile(specFile)
+			files = append(files, specFiles...)
+			if err != nil {
+				return staleCount, files, fmt.Errorf("failed to parse spec %s: %s", specFile, err.Error())
+			}
+			specifications[specFile] = specification
+		}
+		console.PrintLnF("Running generator: %s", run.Generator.Name)
+		count, err := generate(run.Generator, specification, run.Params, configDir, check, processedFile)
+		staleCount += count
+		if err != nil {
+			return staleCount, files, err
+		}
+	}
+	return staleCount, files, nil
+}
@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"gotest.tools/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var runTestGenerators = []Generator{
+	{
+		"files-test",
+		"Test Files",
+		"Generate test files",
+		[]GeneratorArg{
+			{Arg: ArgSpecFile, Required: true},
+			{Arg: ArgGeneratePath, Required: true},
+		},
+		func(specification *spec.Spec, params GeneratorArgsValues) (*Sources, error) {
+			sources := NewSources()
+			sources.AddGenerated(&CodeFile{filepath.Join(params[ArgGeneratePath], "name.txt"), specification.Name.Source})
+			return sources, nil
+		},
+	},
+	{
+		"version-test",
+		"Test Version",
+		"Generate test version file",
+		[]GeneratorArg{
+			{Arg: ArgSpecFile, Required: true},
+			{Arg: ArgGeneratePath, Required: true},
+		},
+		func(specification *spec.Spec, params GeneratorArgsValues) (*Sources, error) {
+			sources := NewSources()
+			sources.AddGenerated(&CodeFile{filepath.Join(params[ArgGeneratePath], "version.txt"), specification.Version})
+			return sources, nil
+		},
+	},
+}
+
+func Test_RunGenerators_PathsRelativeToConfigDir(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "project")
+	err := os.MkdirAll(filepath.Join(configDir, "spec"), 0755)
+	assert.NilError(t, err)
+	err = os.WriteFile(filepath.Join(configDir, "spec", "spec.yaml"), []byte("spec: 2.1\nname: testing\nversion: 1\n"), 0644)
+	assert.NilError(t, err)
+	configFile := filepath.Join(configDir, ConfigFileName)
+	err = os.WriteFile(configFile, []byte("spec-file: spec/spec.yaml\ngenerators:\n  - generator: files-test\n    generate-path: out\n"), 0644)
+	assert.NilError(t, err)
+
+	workingDir, err := os.Getwd()
+	assert.NilError(t, err)
+
+	runs, err := readRuns(configFile, runTestGenerators)
+	assert.NilError(t, err)
+	_, specFiles, err := runGenerators(runs, filepath.Dir(configFile), false, nil)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, specFiles, []string{filepath.Join(configDir, "spec", "spec.yaml")})
+	content, err := os.ReadFile(filepath.Join(configDir, "out", "name.txt"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "testing")
+	assert.Equal(t, exists(filepath.Join(configDir, "out", manifestFileName("files-test"))), true)
+
+	currentDir, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.Equal(t, currentDir, workingDir)
+}
+
+func Test_RunGenerators_CheckAfterRunWithSharedGeneratePath(t *testing.T) {
+	configDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(configDir, "spec.yaml"), []byte("spec: 2.1\nname: testing\nversion: 1\n"), 0644)
+	assert.NilError(t, err)
+	configFile := filepath.Join(configDir, ConfigFileName)
+	config := "spec-file: spec.yaml\ngenerators:\n  - generator: files-test\n    generate-path: out\n  - generator: version-test\n    generate-path: out\n"
+	err = os.WriteFile(configFile, []byte(config), 0644)
+	assert.NilError(t, err)
+
+	runs, err := readRuns(configFile, runTestGenerators)
+	assert.NilError(t, err)
+	_, _, err = runGenerators(runs, configDir, false, nil)
+	assert.NilError(t, err)
+
+	staleCount, _, err := runGenerators(runs, configDir, true, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, staleCount, 0)
+
+	_, _, err = runGenerators(runs, configDir, false, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, exists(filepath.Join(configDir, "out", "name.txt")), true)
+	assert.Equal(t, exists(filepath.Join(configDir, "out", "version.txt")), true)
+}
@@ -1,12 +1,17 @@
 package generator
 
+import (
+	"path/filepath"
+)
+
 type Sources struct {
 	Generated  []CodeFile
 	Scaffolded []CodeFile
+	Stale      []StaleFile
 }
 
 func NewSources() *Sources {
-	return &Sources{[]CodeFile{}, []CodeFile{}}
+	return &Sources{[]CodeFile{}, []CodeFile{}, []StaleFile{}}
 }
 
 func (sources *Sources) AddScaffolded(files ...*CodeFile) {
@@ -37,6 +42,23 @@ func (sources *Sources) AddGeneratedAll(files []CodeFile) {
 	}
 }
 
+// InDir makes relative paths of all files relative to dir instead of the current directory.
+func (sources *Sources) InDir(dir string) {
+	for index := range sources.Generated {
+		sources.Generated[index].Path = resolvePath(dir, sources.Generated[index].Path)
+	}
+	for index := range sources.Scaffolded {
+		sources.Scaffolded[index].Path = resolvePath(dir, sources.Scaffolded[index].Path)
+	}
+}
+
+func resolvePath(dir string, path string) string {
+	if dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
 func (sources *Sources) Write(overwriteAll bool, processedFile ProcessedFileCallback) error {
 	err := WriteFiles(sources.Scaffolded, false || overwriteAll, processedFile)
 	if err != nil {
@@ -49,3 +71,45 @@ func (sources *Sources) Write(overwriteAll bool, processedFile ProcessedFileCall
 	}
 	return nil
 }
+
+func (sources *Sources) Check(staleFile StaleFileCallback) error {
+	err := CheckFiles(sources.Scaffolded, false, staleFile)
+	if err != nil {
+		return err
+	}
+
+	err = CheckFiles(withoutMissingManifests(sources.Generated), true, staleFile)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range sources.Stale {
+		fullpath, err := filepath.Abs(file.Path)
+		if err != nil {
+			return err
+		}
+		diff, err := RemovalDiff(file.Path)
+		if err != nil {
+			return err
+		}
+		staleFile(fullpath, diff)
+	}
+	return nil
+}
+
+// withoutMissingManifests skips manifests that don't exist yet: a missing manifest means there is no previous
+// manifest, e.g. code was generated by an older version, so it is not reported as out of date.
+func withoutMissingManifests(files []CodeFile) []CodeFile {
+	result := []CodeFile{}
+	for _, file := range files {
+		if isManifest(file.Path) && !exists(file.Path) {
+			continue
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+func (sources *Sources) Prune(removedFile RemovedFileCallback) error {
+	return RemoveFiles(sources.Stale, removedFile)
+}
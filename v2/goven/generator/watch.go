@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
+	"os"
+	"sort"
+	"time"
+)
+
+const watchInterval = 500 * time.Millisecond
+
+// WatchFunc regenerates code and returns paths of files to watch for changes.
+type WatchFunc func() []string
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+type watchedFiles map[string]fileState
+
+func statFiles(paths []string) watchedFiles {
+	files := watchedFiles{}
+	for _, path := range paths {
+		state := fileState{}
+		if info, err := os.Stat(path); err == nil {
+			state = fileState{info.ModTime(), info.Size()}
+		}
+		files[path] = state
+	}
+	return files
+}
+
+func (files watchedFiles) changed() []string {
+	changed := []string{}
+	for path, state := range files {
+		current := statFiles([]string{path})[path]
+		if current != state {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+func Watch(regenerate WatchFunc) {
+	watch(regenerate, watchInterval, nil)
+}
+
+func watch(regenerate WatchFunc, interval time.Duration, stop <-chan struct{}) {
+	files := statFiles(regenerateSafely(regenerate, []string{}))
+	console.PrintLn("Watching for changes, press Ctrl+C to stop")
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+		changed := files.changed()
+		if len(changed) > 0 {
+			for _, path := range changed {
+				console.PrintLn("Changed:", path)
+			}
+			paths := make([]string, 0, len(files))
+			for path := range files {
+				paths = append(paths, path)
+			}
+			files = statFiles(regenerateSafely(regenerate, paths))
+			console.PrintLn("Watching for changes, press Ctrl+C to stop")
+		}
+	}
+}
+
+func regenerateSafely(regenerate WatchFunc, previous []string) (paths []string) {
+	defer func() {
+		if err := recover(); err != nil {
+			console.ProblemLn("Failed to generate code")
+			console.ProblemLn(err)
+			paths = previous
+		}
+	}()
+	return regenerate()
+}
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"gotest.tools/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_WatchedFiles_Changed(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "spec.yaml")
+	imported := filepath.Join(dir, "imported.yaml")
+	err := os.WriteFile(spec, []byte("spec: 2.1\n"), 0644)
+	assert.NilError(t, err)
+
+	files := statFiles([]string{spec, imported})
+	assert.DeepEqual(t, files.changed(), []string{})
+
+	err = os.WriteFile(imported, []byte("models: {}\n"), 0644)
+	assert.NilError(t, err)
+	err = os.WriteFile(spec, []byte("spec: 2.1\nname: test\n"), 0644)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, files.changed(), []string{imported, spec})
+}
+
+func Test_Watch_RegeneratesOnChange(t *testing.T) {
+	spec := filepath.Join(t.TempDir(), "spec.yaml")
+	err := os.WriteFile(spec, []byte("spec: 2.1\n"), 0644)
+	assert.NilError(t, err)
+
+	runs := make(chan bool, 10)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		watch(func() []string {
+			runs <- true
+			if len(runs) > 1 {
+				panic("generator failed")
+			}
+			return []string{spec}
+		}, 10*time.Millisecond, stop)
+		close(done)
+	}()
+
+	waitRuns := func(expected int) {
+		deadline := time.Now().Add(5 * time.Second)
+		for len(runs) < expected && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(t, len(runs), expected)
+	}
+
+	waitRuns(1)
+	err = os.WriteFile(spec, []byte("spec: 2.1\nname: test\n"), 0644)
+	assert.NilError(t, err)
+	waitRuns(2)
+	err = os.WriteFile(spec, []byte("spec: 2.1\nname: test2\n"), 0644)
+	assert.NilError(t, err)
+	waitRuns(3)
+
+	close(stop)
+	<-done
+}
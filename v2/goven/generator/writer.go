@@ -85,9 +85,16 @@ func (w *writer) checkAligned() {
 	lines := w.content.linesAligned
 	w.content.linesAligned = []string{}
 
+	columns := -1
+	for _, line := range lines {
+		lineColumns := len(strings.Split(line, " "))
+		if columns == -1 || lineColumns < columns {
+			columns = lineColumns
+		}
+	}
 	linesParts := [][]string{}
 	for _, line := range lines {
-		linesParts = append(linesParts, strings.Split(line, " "))
+		linesParts = append(linesParts, strings.SplitN(line, " ", columns))
 	}
 
 	widths := make([]int, len(linesParts[0]))
@@ -44,3 +44,14 @@ line1
 	w.Line("        line3")
 	assert.Equal(t, strings.TrimSpace(w.String()), strings.TrimSpace(expected))
 }
+
+func Test_Lines_Aligned(t *testing.T) {
+	expected := `
+Ok        <-chan models.Message
+NoContent *empty.Type
+`
+	w := NewWriter(Config{"  ", 0, nil})
+	w.LineAligned("Ok <-chan models.Message")
+	w.LineAligned("NoContent *empty.Type")
+	assert.Equal(t, strings.TrimSpace(w.String()), strings.TrimSpace(expected))
+}
@@ -0,0 +1,68 @@
+package importopenapi
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const InFile = "in-file"
+const Name = "name"
+
+func AddCobraCommand(parent *cobra.Command) {
+	parent.AddCommand(importCommand())
+}
+
+func importCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import-openapi",
+		Short: "Convert OpenAPI 3 document into specification",
+		Run: func(cmd *cobra.Command, args []string) {
+			inFile, _ := cmd.Flags().GetString(InFile)
+			outFile, _ := cmd.Flags().GetString(generator.OutFile)
+			name, _ := cmd.Flags().GetString(Name)
+
+			console.PrintLnF("Reading OpenAPI file: %s", inFile)
+			specification, messages, err := ImportFile(inFile, name)
+			if messages != nil {
+				sort.Sort(messages.Items)
+				for _, message := range messages.Items {
+					if message.Level != spec.LevelError {
+						console.PrintLnF(message.String())
+					} else {
+						console.ProblemLnF(message.String())
+					}
+				}
+			}
+			if err != nil {
+				console.ProblemLnF("Failed to import OpenAPI file: %s", inFile)
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+
+			data, err := spec.WriteSpec(specification)
+			if err == nil {
+				err = os.MkdirAll(filepath.Dir(outFile), os.ModePerm)
+			}
+			if err == nil {
+				err = os.WriteFile(outFile, data, 0644)
+			}
+			if err != nil {
+				console.ProblemLnF("Failed to write specification: %s", outFile)
+				console.ProblemLn(err)
+				os.Exit(1)
+			}
+			console.PrintLn("Writing:", outFile)
+		},
+	}
+	command.Flags().String(InFile, "", "path to OpenAPI 3 document in YAML or JSON format")
+	command.Flags().String(generator.OutFile, "", generator.OutFileDescription)
+	command.Flags().String(Name, "", "name of the specification; defaults to OpenAPI title")
+	command.MarkFlagRequired(InFile)
+	command.MarkFlagRequired(generator.OutFile)
+	return command
+}
@@ -0,0 +1,600 @@
+package importopenapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/goven/yamlx"
+	"gopkg.in/specgen-io/yaml.v3"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const specVersion = "2.1"
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+var standardErrorResponses = map[string]string{
+	"400": "BadRequestError",
+	"404": "NotFoundError",
+	"500": "InternalServerError",
+}
+
+type importer struct {
+	document      *yaml.Node
+	messages      *spec.Messages
+	reported      map[string]bool
+	schemas       map[string]*schema
+	schemasOrder  []string
+	resolving     map[string]bool
+	security      map[string]string
+	usedSchemas   []*yaml.Node
+	errorSchemas  []*yaml.Node
+	errorBodies   map[string]string
+	errorsOrder   []string
+	operationsIds map[string]bool
+}
+
+func ImportFile(path string, name string) (*spec.Spec, *spec.Messages, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	specification, messages, err := Import(data, name)
+	for index := range messages.Items {
+		if message := &messages.Items[index]; message.Location != nil && message.File == "" {
+			message.File = path
+		}
+	}
+	return specification, messages, err
+}
+
+func Import(data []byte, name string) (*spec.Spec, *spec.Messages, error) {
+	messages := spec.NewMessages()
+	var document yaml.Node
+	err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&document)
+	if err != nil || len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		messages.Add(spec.Error("failed to parse OpenAPI document"))
+		return nil, messages, errors.New("failed to read OpenAPI document")
+	}
+
+	importer := &importer{
+		document:      document.Content[0],
+		messages:      messages,
+		reported:      map[string]bool{},
+		schemas:       map[string]*schema{},
+		resolving:     map[string]bool{},
+		security:      map[string]string{},
+		errorBodies:   map[string]string{},
+		operationsIds: map[string]bool{},
+	}
+	specification := importer.specification(name)
+	if messages.ContainsLevel(spec.LevelError) {
+		return nil, messages, errors.New("failed to import OpenAPI document")
+	}
+
+	specData, err := yamlx.ToYamlString(specification)
+	if err != nil {
+		return nil, messages, err
+	}
+	result, specMessages, err := spec.ReadSpec([]byte(specData))
+	if specMessages != nil {
+		for _, message := range specMessages.Items {
+			message.Message = "imported specification: " + message.Message
+			message.Location = nil
+			messages.Add(message)
+		}
+	}
+	if err != nil {
+		return nil, messages, errors.New("imported specification is not valid")
+	}
+	return result, messages, nil
+}
+
+func (importer *importer) report(message spec.Message, node *yaml.Node) {
+	if node != nil {
+		message = message.At(&spec.Location{Line: node.Line, Column: node.Column})
+	}
+	key := fmt.Sprintf("%v", message)
+	if !importer.reported[key] {
+		importer.reported[key] = true
+		importer.messages.Add(message)
+	}
+}
+
+func (importer *importer) warning(node *yaml.Node, format string, args ...interface{}) {
+	importer.report(spec.Warning(format, args...), node)
+}
+
+func (importer *importer) error(node *yaml.Node, format string, args ...interface{}) {
+	importer.report(spec.Error(format, args...), node)
+}
+
+func (importer *importer) specification(name string) *yamlx.YamlMap {
+	openapiVersion := scalarValue(importer.document, "openapi")
+	if !strings.HasPrefix(openapiVersion, "3.") {
+		importer.error(importer.document, "only OpenAPI 3 documents are supported, found version: %s", openapiVersion)
+		return nil
+	}
+
+	info := mappingValue(importer.document, "info")
+	title := scalarValue(info, "title")
+	if name == "" {
+		name = strings.Join(words(title), "-")
+	}
+	version := scalarValue(info, "version")
+	if version == "" {
+		version = "1"
+	}
+
+	specification := yamlx.Map()
+	specification.Add("spec", yamlx.String(specVersion))
+	specification.Add("name", name)
+	if title != "" && title != name {
+		specification.Add("title", title)
+	}
+	if description := scalarValue(info, "description"); description != "" {
+		specification.Add("description", description)
+	}
+	specification.Add("version", version)
+
+	importer.readSchemas()
+	if security := importer.securitySchemes(); security != nil {
+		specification.Add("security", security)
+	}
+
+	http := importer.http()
+	if len(http.Node.Content) > 0 {
+		specification.Add("http", http)
+	}
+
+	usedRefs := map[string]bool{}
+	for _, node := range importer.usedSchemas {
+		importer.schemaRefs(node, usedRefs)
+	}
+	errorRefs := map[string]bool{}
+	for _, node := range importer.errorSchemas {
+		importer.schemaRefs(node, errorRefs)
+	}
+	models := []string{}
+	errorModels := []string{}
+	for _, name := range importer.schemasOrder {
+		if errorRefs[name] {
+			errorModels = append(errorModels, name)
+		}
+		if containsString(standardErrorModels, name) && !usedRefs[name] {
+			continue
+		}
+		if !errorRefs[name] || usedRefs[name] {
+			models = append(models, name)
+		}
+	}
+
+	if len(importer.errorsOrder) > 0 {
+		errorResponses := yamlx.Map()
+		for _, statusName := range importer.errorsOrder {
+			errorResponses.Add(statusName, importer.errorBodies[statusName])
+		}
+		httpErrors := singleMap("responses", errorResponses)
+		if len(errorModels) > 0 {
+			httpErrors.Add("models", importer.models(errorModels))
+		}
+		specification.Add("errors", httpErrors)
+	}
+	if len(models) > 0 {
+		specification.Add("models", importer.models(models))
+	}
+	return specification
+}
+
+func (importer *importer) securitySchemes() *yamlx.YamlMap {
+	schemesNode := mappingValue(mappingValue(importer.document, "components"), "securitySchemes")
+	if schemesNode == nil {
+		return nil
+	}
+	schemes := yamlx.Map()
+	for _, pair := range mappingPairs(schemesNode) {
+		node := pair.Value
+		scheme := yamlx.Map()
+		switch scalarValue(node, "type") {
+		case "http":
+			switch strings.ToLower(scalarValue(node, "scheme")) {
+			case "bearer":
+				scheme.Add("type", string(spec.SecurityBearer))
+			case "basic":
+				scheme.Add("type", string(spec.SecurityBasic))
+			default:
+				importer.warning(node, "http security scheme %s is not supported and is skipped", scalarValue(node, "scheme"))
+				continue
+			}
+		case "apiKey":
+			in := scalarValue(node, "in")
+			if in != spec.ApiKeyInHeader && in != spec.ApiKeyInQuery {
+				importer.warning(node, "api key in %s is not supported and is skipped", in)
+				continue
+			}
+			scheme.Add("type", string(spec.SecurityApiKey))
+			scheme.Add("in", in)
+			scheme.Add("name", scalarValue(node, "name"))
+		default:
+			importer.warning(node, "security scheme of type %s is not supported and is skipped", scalarValue(node, "type"))
+			continue
+		}
+		if description := scalarValue(node, "description"); description != "" {
+			scheme.Add("description", description)
+		}
+		name := snakeName(pair.Key.Value)
+		importer.security[pair.Key.Value] = name
+		schemes.Add(name, scheme)
+	}
+	if len(schemes.Node.Content) == 0 {
+		return nil
+	}
+	return schemes
+}
+
+func (importer *importer) securityRefs(node *yaml.Node) *yamlx.YamlArray {
+	refs := yamlx.Array()
+	for _, requirement := range node.Content {
+		if len(requirement.Content) != 2 {
+			importer.warning(requirement, "security requirement should declare exactly one scheme, it is skipped")
+			continue
+		}
+		name, found := importer.security[requirement.Content[0].Value]
+		if !found {
+			continue
+		}
+		scopes := []string{}
+		for _, scope := range requirement.Content[1].Content {
+			scopes = append(scopes, scope.Value)
+		}
+		if len(scopes) > 0 {
+			refs.Add(singleMap(name, scopes))
+		} else {
+			refs.Add(name)
+		}
+	}
+	return refs
+}
+
+func (importer *importer) http() *yamlx.YamlMap {
+	http := yamlx.Map()
+	if securityNode := mappingValue(importer.document, "security"); securityNode != nil {
+		if refs := importer.securityRefs(securityNode); refs.Length() > 0 {
+			http.Add("security", refs)
+		}
+	}
+	apis := map[string]*yamlx.YamlMap{}
+	apisOrder := []string{}
+	for _, pathPair := range mappingPairs(mappingValue(importer.document, "paths")) {
+		path := pathPair.Key.Value
+		pathItem := pathPair.Value
+		if mappingValue(pathItem, "$ref") != nil {
+			importer.warning(pathItem, "path item reference is not supported, path %s is skipped", path)
+			continue
+		}
+		for _, pair := range mappingPairs(pathItem) {
+			method := pair.Key.Value
+			if !containsString(httpMethods, method) {
+				continue
+			}
+			if method == "trace" {
+				importer.warning(pair.Key, "TRACE operations are not supported, %s %s is skipped", strings.ToUpper(method), path)
+				continue
+			}
+			apiName := importer.apiName(pair.Value, path)
+			if _, found := apis[apiName]; !found {
+				apis[apiName] = yamlx.Map()
+				apisOrder = append(apisOrder, apiName)
+			}
+			operationName := importer.operationName(apiName, pair.Value, method, path)
+			apis[apiName].Add(operationName, importer.operation(pair.Value, sequenceItems(pathItem, "parameters"), method, path))
+		}
+	}
+	for _, apiName := range apisOrder {
+		http.Add(apiName, apis[apiName])
+	}
+	return http
+}
+
+func (importer *importer) apiName(operation *yaml.Node, path string) string {
+	if tags := sequenceItems(operation, "tags"); len(tags) > 0 {
+		if name := snakeName(tags[0].Value); name != "" {
+			return name
+		}
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			if name := snakeName(segment); name != "" {
+				return name
+			}
+		}
+	}
+	return "default"
+}
+
+func (importer *importer) operationName(apiName string, operation *yaml.Node, method string, path string) string {
+	name := snakeName(scalarValue(operation, "operationId"))
+	if name == "" {
+		name = snakeName(method + " " + path)
+	}
+	if strings.HasPrefix(name, apiName+"_") {
+		name = strings.TrimPrefix(name, apiName+"_")
+	}
+	if importer.operationsIds[apiName+"."+name] {
+		index := 2
+		for importer.operationsIds[fmt.Sprintf("%s.%s_%d", apiName, name, index)] {
+			index++
+		}
+		importer.warning(operation, "operation name %s is already used in api %s, renamed to %s_%d", name, apiName, name, index)
+		name = fmt.Sprintf("%s_%d", name, index)
+	}
+	importer.operationsIds[apiName+"."+name] = true
+	return name
+}
+
+func (importer *importer) resolve(node *yaml.Node) *yaml.Node {
+	ref := scalarValue(node, "$ref")
+	if ref == "" {
+		return node
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		importer.error(node, "external reference is not supported: %s", ref)
+		return nil
+	}
+	resolved := importer.document
+	for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		resolved = mappingValue(resolved, strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~"))
+	}
+	if resolved == nil {
+		importer.error(node, "unknown reference: %s", ref)
+	}
+	return resolved
+}
+
+func (importer *importer) operation(node *yaml.Node, pathParameters []*yaml.Node, method string, path string) *yamlx.YamlMap {
+	parameters := map[string]*yamlx.YamlMap{"path": yamlx.Map(), "header": yamlx.Map(), "query": yamlx.Map(), "cookie": yamlx.Map()}
+	urlParamsTypes := map[string]string{}
+	for _, parameterNode := range append(append([]*yaml.Node{}, pathParameters...), sequenceItems(node, "parameters")...) {
+		parameter := importer.resolve(parameterNode)
+		if parameter == nil {
+			continue
+		}
+		name := scalarValue(parameter, "name")
+		in := scalarValue(parameter, "in")
+		schemaNode := mappingValue(parameter, "schema")
+		importer.usedSchemas = append(importer.usedSchemas, schemaNode)
+		switch in {
+		case "path":
+			urlParamsTypes[name] = importer.typeName(schemaNode)
+		case "header", "query", "cookie":
+			definition := importer.definition(schemaNode, scalarValue(parameter, "required") == "true", true)
+			parameters[in].AddWithComment(name, definition, descriptionComment(parameter))
+		default:
+			importer.warning(parameter, "%s parameter %s is not supported and is skipped", in, name)
+		}
+	}
+
+	url := path
+	for _, match := range regexp.MustCompile(`\{([^}]+)}`).FindAllStringSubmatch(path, -1) {
+		typeName, found := urlParamsTypes[match[1]]
+		if !found {
+			importer.warning(node, "url parameter %s is not declared, it is imported as string", match[1])
+			typeName = spec.TypeString
+		}
+		url = strings.Replace(url, match[0], fmt.Sprintf("{%s:%s}", match[1], typeName), 1)
+	}
+
+	operation := yamlx.Map()
+	operation.Add("endpoint", strings.ToUpper(method)+" "+url)
+	if description := descriptionComment(node); description != nil {
+		operation.Add("description", strings.TrimPrefix(*description, "# "))
+	}
+	if len(parameters["header"].Node.Content) > 0 {
+		operation.Add("header", parameters["header"])
+	}
+	if len(parameters["query"].Node.Content) > 0 {
+		operation.Add("query", parameters["query"])
+	}
+	if len(parameters["cookie"].Node.Content) > 0 {
+		operation.Add("cookie", parameters["cookie"])
+	}
+	if requestBody := mappingValue(node, "requestBody"); requestBody != nil {
+		if body := importer.requestBody(importer.resolve(requestBody)); body != nil {
+			operation.Add("body", body)
+		}
+	}
+	if securityNode := mappingValue(node, "security"); securityNode != nil {
+		if len(securityNode.Content) == 0 {
+			operation.Add("security", yamlx.Array())
+		} else if refs := importer.securityRefs(securityNode); refs.Length() > 0 {
+			operation.Add("security", refs)
+		}
+	}
+	operation.Add("response", importer.responses(mappingValue(node, "responses")))
+	return operation
+}
+
+func contentByType(content *yaml.Node) (string, *yaml.Node) {
+	pairs := mappingPairs(content)
+	for _, pair := range pairs {
+		if strings.Contains(pair.Key.Value, "json") {
+			return pair.Key.Value, pair.Value
+		}
+	}
+	for _, pair := range pairs {
+		if strings.HasPrefix(pair.Key.Value, "text/") {
+			return pair.Key.Value, pair.Value
+		}
+	}
+	if len(pairs) > 0 {
+		return pairs[0].Key.Value, pairs[0].Value
+	}
+	return "", nil
+}
+
+func (importer *importer) requestBody(node *yaml.Node) interface{} {
+	if node == nil {
+		return nil
+	}
+	contentType, media := contentByType(mappingValue(node, "content"))
+	schemaNode := mappingValue(media, "schema")
+	required := scalarValue(node, "required") == "true"
+	switch {
+	case contentType == "multipart/form-data" || contentType == "application/x-www-form-urlencoded":
+		importer.usedSchemas = append(importer.usedSchemas, schemaNode)
+		kind := "form-data"
+		if contentType == "application/x-www-form-urlencoded" {
+			kind = "form-urlencoded"
+		}
+		params := yamlx.Map()
+		for _, property := range importer.properties(schemaNode) {
+			var definition string
+			if kind == "form-data" && isBinarySchema(importer.resolveSchema(property.Schema)) {
+				definition = spec.TypeFile
+				if !property.Required {
+					definition += "?"
+				}
+			} else {
+				definition = importer.definition(property.Schema, property.Required, true)
+			}
+			params.AddWithComment(property.Name.Value, definition, descriptionComment(importer.resolveSchema(property.Schema)))
+		}
+		return singleMap(kind, params)
+	case strings.Contains(contentType, "json"):
+		importer.usedSchemas = append(importer.usedSchemas, schemaNode)
+		typeName := importer.bodyTypeName(media, schemaNode)
+		if !required && !strings.HasSuffix(typeName, "?") {
+			typeName += "?"
+		}
+		return importer.bodyDefinition(typeName, node)
+	case contentType == "application/octet-stream":
+		return importer.bodyDefinition(spec.TypeFile, node)
+	case contentType == "":
+		return nil
+	default:
+		if contentType != "text/plain" {
+			importer.warning(media, "content type %s is not supported, body is imported as string", contentType)
+		}
+		return importer.bodyDefinition(spec.TypeString, node)
+	}
+}
+
+func (importer *importer) bodyTypeName(media *yaml.Node, schemaNode *yaml.Node) string {
+	typeName := importer.typeName(schemaNode)
+	baseName := strings.TrimSuffix(typeName, "?")
+	if spec.TypesAliases[baseName] != "" || containsString([]string{spec.TypeFloat, spec.TypeDouble, spec.TypeDecimal, spec.TypeUuid, spec.TypeDate, spec.TypeDateTime}, baseName) {
+		importer.warning(media, "json body of type %s is not supported, it is imported as json", baseName)
+		return spec.TypeJson
+	}
+	if baseName == spec.TypeString {
+		importer.warning(media, "json string body is imported as text body")
+	}
+	return typeName
+}
+
+func (importer *importer) bodyDefinition(typeName string, node *yaml.Node) interface{} {
+	comment := descriptionComment(node)
+	if comment == nil {
+		return typeName
+	}
+	value := yamlx.String(typeName)
+	value.LineComment = *comment
+	return value
+}
+
+func (importer *importer) responses(node *yaml.Node) *yamlx.YamlMap {
+	responses := yamlx.Map()
+	for _, pair := range mappingPairs(node) {
+		statusCode := pair.Key.Value
+		if !spec.IsHttpStatusCode(statusCode) {
+			importer.warning(pair.Key, "response %s is not supported and is skipped", statusCode)
+			continue
+		}
+		response := importer.resolve(pair.Value)
+		if response == nil {
+			continue
+		}
+		statusName := spec.HttpStatusName(statusCode)
+		contentType, media := contentByType(mappingValue(response, "content"))
+		schemaNode := mappingValue(media, "schema")
+		code, _ := strconv.Atoi(statusCode)
+		headers := importer.responseHeaders(mappingValue(response, "headers"), code >= 400, statusCode)
+
+		if standardModel, found := standardErrorResponses[statusCode]; found {
+			if scalarValue(schemaNode, "$ref") != schemasRefPrefix+standardModel {
+				importer.warning(pair.Key, "response %s is replaced with standard error response", statusCode)
+			}
+			continue
+		}
+
+		typeName := "empty"
+		switch {
+		case contentType == "":
+		case contentType == "application/x-ndjson" && code < 400:
+			typeName = fmt.Sprintf("stream<%s>", importer.bodyTypeName(media, schemaNode))
+		case contentType == "text/event-stream" && code < 400:
+			typeName = fmt.Sprintf("sse<%s>", importer.bodyTypeName(media, schemaNode))
+		case strings.Contains(contentType, "json"):
+			typeName = importer.bodyTypeName(media, schemaNode)
+		case contentType == "application/octet-stream" && code < 400:
+			typeName = spec.TypeFile
+		default:
+			if !strings.HasPrefix(contentType, "text/") {
+				importer.warning(media, "content type %s is not supported, response is imported as string", contentType)
+			}
+			typeName = spec.TypeString
+		}
+
+		if code >= 400 {
+			importer.errorSchemas = append(importer.errorSchemas, schemaNode)
+			if declared, found := importer.errorBodies[statusName]; found && declared != typeName {
+				importer.warning(pair.Key, "response %s is declared with different bodies across operations: %s and %s, it is skipped", statusCode, declared, typeName)
+				continue
+			}
+			if _, found := importer.errorBodies[statusName]; !found {
+				importer.errorBodies[statusName] = typeName
+				importer.errorsOrder = append(importer.errorsOrder, statusName)
+			}
+		} else {
+			importer.usedSchemas = append(importer.usedSchemas, schemaNode)
+		}
+		if len(headers.Node.Content) > 0 {
+			value := yamlx.Map()
+			if typeName != "empty" {
+				value.Add("body", typeName)
+			}
+			value.Add("header", headers)
+			responses.AddWithComment(statusName, value, descriptionComment(response))
+		} else {
+			responses.AddWithComment(statusName, typeName, descriptionComment(response))
+		}
+	}
+	return responses
+}
+
+func (importer *importer) responseHeaders(node *yaml.Node, isError bool, statusCode string) *yamlx.YamlMap {
+	headers := yamlx.Map()
+	for _, pair := range mappingPairs(node) {
+		name := pair.Key.Value
+		if strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		if isError {
+			importer.warning(pair.Key, "header %s of error response %s is not supported and is skipped", name, statusCode)
+			continue
+		}
+		header := importer.resolve(pair.Value)
+		if header == nil {
+			continue
+		}
+		schemaNode := mappingValue(header, "schema")
+		importer.usedSchemas = append(importer.usedSchemas, schemaNode)
+		definition := importer.definition(schemaNode, scalarValue(header, "required") == "true", true)
+		headers.AddWithComment(name, definition, descriptionComment(header))
+	}
+	return headers
+}
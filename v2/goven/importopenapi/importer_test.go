@@ -0,0 +1,455 @@
+package importopenapi
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"gotest.tools/assert"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func checkImport(t *testing.T, openapi string, expectedSpec string) *spec.Messages {
+	specification, messages, err := Import([]byte(strings.TrimLeft(openapi, "\n")), "")
+	assert.NilError(t, err, messagesStrings(messages))
+	data, err := spec.WriteSpec(specification)
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(data)), strings.TrimSpace(expectedSpec))
+	return messages
+}
+
+func messagesStrings(messages *spec.Messages) []string {
+	sort.Sort(messages.Items)
+	result := []string{}
+	for _, message := range messages.Items {
+		result = append(result, message.String())
+	}
+	return result
+}
+
+func Test_Import_Models(t *testing.T) {
+	openapi := `
+openapi: 3.0.3
+info:
+  title: Pet Store
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      description: A pet
+      required: [id, name]
+      properties:
+        id:
+          type: string
+          format: uuid
+        name:
+          type: string
+          minLength: 1
+          description: Name of the pet
+        tags:
+          type: array
+          items:
+            type: string
+        status:
+          $ref: '#/components/schemas/PetStatus'
+        attributes:
+          type: object
+          additionalProperties:
+            type: integer
+            format: int64
+    PetStatus:
+      type: string
+      enum: [available, SOLD]
+    Animal:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: '#/components/schemas/Cat'
+    Cat:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - type: object
+          properties:
+            lives:
+              type: integer
+              default: 9
+    Dog:
+      type: object
+      properties:
+        good:
+          type: boolean
+`
+	expectedSpec := `
+spec: 2.1
+name: pet-store
+title: Pet Store
+version: 1.0.0
+models:
+  Pet:
+    description: A pet
+    object:
+      id: uuid
+      name: string(min=1) # Name of the pet
+      tags: string[]?
+      status: PetStatus?
+      attributes: long{}?
+  PetStatus:
+    enum:
+      available: available
+      sold: SOLD
+  Animal:
+    discriminator: kind
+    oneOf:
+      cat: Cat
+      dog: Dog
+  Cat:
+    object:
+      id: uuid
+      name: string(min=1) # Name of the pet
+      tags: string[]?
+      status: PetStatus?
+      attributes: long{}?
+      lives: int?
+  Dog:
+    object:
+      good: boolean?
+`
+	messages := checkImport(t, openapi, expectedSpec)
+	assert.DeepEqual(t, messagesStrings(messages), []string{
+		"warning - at (42, 5): allOf schema Cat is flattened into object",
+		"warning - at (48, 15): default value of model field is not supported and is skipped",
+	})
+}
+
+func Test_Import_Http(t *testing.T) {
+	openapi := `
+openapi: 3.0.3
+info:
+  title: Pet Store
+  version: "1"
+security:
+  - bearerAuth: []
+paths:
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: integer
+          format: int64
+    get:
+      operationId: getPet
+      tags: [pets]
+      summary: Find pet by id
+      parameters:
+        - $ref: '#/components/parameters/Verbose'
+        - name: X-Trace
+          in: header
+          schema:
+            type: string
+            maxLength: 16
+        - name: session
+          in: cookie
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+        "404":
+          description: Not found
+        "409":
+          description: Conflict
+          headers:
+            Retry-After:
+              schema:
+                type: integer
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Problem'
+        default:
+          description: Unexpected error
+    put:
+      tags: [pets]
+      security:
+        - apiKey: [write]
+      requestBody:
+        content:
+          application/x-www-form-urlencoded:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name:
+                  type: string
+                age:
+                  type: integer
+                  default: 1
+      responses:
+        "204":
+          description: Updated
+  /pets/{petId}/notes:
+    post:
+      tags: [pets]
+      operationId: pets_add_note
+      security: []
+      requestBody:
+        required: true
+        content:
+          text/plain:
+            schema:
+              type: string
+      responses:
+        "201":
+          description: Created
+          headers:
+            Location:
+              description: Note url
+              required: true
+              schema:
+                type: string
+            Content-Type:
+              schema:
+                type: string
+          content:
+            text/plain:
+              schema:
+                type: string
+components:
+  parameters:
+    Verbose:
+      name: verbose
+      in: query
+      description: Return all details
+      schema:
+        type: boolean
+        default: false
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-Api-Key
+    oauth:
+      type: oauth2
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+    Problem:
+      type: object
+      required: [message]
+      properties:
+        message:
+          type: string
+`
+	expectedSpec := `
+spec: 2.1
+name: pet-store
+title: Pet Store
+version: 1
+security:
+  bearer_auth: bearer
+  api_key:
+    type: api-key
+    in: header
+    name: X-Api-Key
+http:
+  security:
+    - bearer_auth
+  pets:
+    get_pet:
+      endpoint: GET /pets/{petId:long}
+      description: Find pet by id
+      header:
+        X-Trace: string(max=16)?
+      query:
+        verbose: boolean = false # Return all details
+      cookie:
+        session: string?
+      response:
+        ok: Pet # The pet
+        conflict: Problem # Conflict
+    put_pets_pet_id:
+      endpoint: PUT /pets/{petId:long}
+      body:
+        form-urlencoded:
+          name: string
+          age: int = 1
+      security:
+        - api_key:
+            - write
+      response:
+        no_content: empty # Updated
+    add_note:
+      endpoint: POST /pets/{petId:string}/notes
+      body: string
+      security: []
+      response:
+        created: # Created
+          body: string
+          header:
+            Location: string # Note url
+models:
+  Pet:
+    object:
+      name: string
+errors:
+  responses:
+    conflict: Problem
+  models:
+    Problem:
+      object:
+        message: string
+`
+	messages := checkImport(t, openapi, expectedSpec)
+	assert.DeepEqual(t, messagesStrings(messages), []string{
+		"warning - at (38, 9): response 404 is replaced with standard error response",
+		"warning - at (43, 13): header Retry-After of error response 409 is not supported and is skipped",
+		"warning - at (50, 9): response default is not supported and is skipped",
+		"warning - at (73, 7): url parameter petId is not declared, it is imported as string",
+		"warning - at (116, 7): security scheme of type oauth2 is not supported and is skipped",
+	})
+}
+
+func Test_Import_FilesAndStreams(t *testing.T) {
+	openapi := `
+openapi: 3.0.0
+info:
+  title: Files
+  version: "1"
+paths:
+  /documents:
+    post:
+      operationId: upload
+      requestBody:
+        required: true
+        content:
+          multipart/form-data:
+            schema:
+              type: object
+              required: [document]
+              properties:
+                document:
+                  type: string
+                  format: binary
+                thumbnail:
+                  type: string
+                  format: binary
+                title:
+                  type: string
+      responses:
+        "204":
+          description: Uploaded
+  /documents/{id}:
+    put:
+      operationId: replace
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      requestBody:
+        required: true
+        content:
+          application/octet-stream:
+            schema:
+              type: string
+              format: binary
+      responses:
+        "200":
+          description: The document
+          content:
+            application/octet-stream:
+              schema:
+                type: string
+                format: binary
+  /documents/export:
+    get:
+      operationId: export
+      responses:
+        "200":
+          description: Exported documents
+          content:
+            application/x-ndjson:
+              schema:
+                $ref: '#/components/schemas/Document'
+  /documents/events:
+    get:
+      operationId: events
+      responses:
+        "200":
+          description: Document events
+          content:
+            text/event-stream:
+              schema:
+                $ref: '#/components/schemas/Document'
+components:
+  schemas:
+    Document:
+      type: object
+      required: [title]
+      properties:
+        title:
+          type: string
+`
+	expectedSpec := `
+spec: 2.1
+name: files
+title: Files
+version: 1
+http:
+  documents:
+    upload:
+      endpoint: POST /documents
+      body:
+        form-data:
+          document: file
+          thumbnail: file?
+          title: string?
+      response:
+        no_content: empty # Uploaded
+    replace:
+      endpoint: PUT /documents/{id:string}
+      body: file
+      response:
+        ok: file # The document
+    export:
+      endpoint: GET /documents/export
+      response:
+        ok: stream<Document> # Exported documents
+    events:
+      endpoint: GET /documents/events
+      response:
+        ok: sse<Document> # Document events
+models:
+  Document:
+    object:
+      title: string
+`
+	messages := checkImport(t, openapi, expectedSpec)
+	assert.DeepEqual(t, messagesStrings(messages), []string{})
+}
+
+func Test_Import_NotOpenapi3(t *testing.T) {
+	_, messages, err := Import([]byte("swagger: \"2.0\"\n"), "")
+	assert.Error(t, err, "failed to import OpenAPI document")
+	assert.DeepEqual(t, messagesStrings(messages), []string{
+		"error - at (1, 1): only OpenAPI 3 documents are supported, found version: ",
+	})
+}
@@ -0,0 +1,50 @@
+package importopenapi
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var nonWordChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+func words(value string) []string {
+	result := []string{}
+	for _, part := range nonWordChars.Split(value, -1) {
+		runes := []rune(part)
+		start := 0
+		for index := 1; index < len(runes); index++ {
+			previous, current := runes[index-1], runes[index]
+			lowerBefore := unicode.IsLower(previous) || unicode.IsDigit(previous)
+			acronymEnd := unicode.IsUpper(previous) && index+1 < len(runes) && unicode.IsLower(runes[index+1])
+			if unicode.IsUpper(current) && (lowerBefore || acronymEnd) {
+				result = append(result, strings.ToLower(string(runes[start:index])))
+				start = index
+			}
+		}
+		if start < len(runes) {
+			result = append(result, strings.ToLower(string(runes[start:])))
+		}
+	}
+	merged := []string{}
+	for _, word := range result {
+		if len(merged) > 0 && unicode.IsDigit(rune(word[0])) {
+			merged[len(merged)-1] += word
+		} else {
+			merged = append(merged, word)
+		}
+	}
+	return merged
+}
+
+func snakeName(value string) string {
+	return strings.Join(words(value), "_")
+}
+
+func pascalName(value string) string {
+	result := ""
+	for _, word := range words(value) {
+		result += strings.ToUpper(word[:1]) + word[1:]
+	}
+	return result
+}
@@ -0,0 +1,17 @@
+package importopenapi
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+func Test_Names(t *testing.T) {
+	assert.Equal(t, snakeName("v2EchoBodyModel"), "v2_echo_body_model")
+	assert.Equal(t, snakeName("getUserByID"), "get_user_by_id")
+	assert.Equal(t, snakeName("HTTPServer"), "http_server")
+	assert.Equal(t, snakeName("get /users/{id}"), "get_users_id")
+	assert.Equal(t, snakeName("item 2"), "item2")
+	assert.Equal(t, pascalName("v2.Message"), "V2Message")
+	assert.Equal(t, pascalName("user_profile"), "UserProfile")
+	assert.Equal(t, pascalName("UserID"), "UserId")
+}
@@ -0,0 +1,383 @@
+package importopenapi
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/goven/yamlx"
+	"gopkg.in/specgen-io/yaml.v3"
+	"strings"
+)
+
+const schemasRefPrefix = "#/components/schemas/"
+
+var standardErrorModels = []string{"BadRequestError", "ValidationError", "ErrorLocation", "InternalServerError", "NotFoundError"}
+
+type schemaKind string
+
+const (
+	schemaObject schemaKind = "object"
+	schemaEnum   schemaKind = "enum"
+	schemaOneOf  schemaKind = "oneOf"
+	schemaAllOf  schemaKind = "allOf"
+	schemaAlias  schemaKind = "alias"
+)
+
+type schema struct {
+	Name   string
+	Model  string
+	Kind   schemaKind
+	Node   *yaml.Node
+	Source *yaml.Node
+}
+
+func (importer *importer) readSchemas() {
+	schemasNode := mappingValue(mappingValue(importer.document, "components"), "schemas")
+	for _, pair := range mappingPairs(schemasNode) {
+		name := pair.Key.Value
+		modelName := pascalName(name)
+		if spec.PascalCase.Check(modelName) != nil {
+			importer.warning(pair.Key, "schema %s can not be converted to model name, it is imported as json", name)
+			importer.schemas[name] = &schema{name, "", schemaAlias, &yaml.Node{Kind: yaml.MappingNode}, pair.Key}
+			continue
+		}
+		if existing := importer.schemaByModel(modelName); existing != nil {
+			importer.warning(pair.Key, "schema %s has the same model name %s as schema %s", name, modelName, existing.Name)
+		}
+		importer.schemas[name] = &schema{name, modelName, importer.kindOf(pair.Value), pair.Value, pair.Key}
+		importer.schemasOrder = append(importer.schemasOrder, name)
+	}
+}
+
+func (importer *importer) schemaByModel(modelName string) *schema {
+	for _, schema := range importer.schemas {
+		if schema.Model == modelName {
+			return schema
+		}
+	}
+	return nil
+}
+
+func (importer *importer) kindOf(node *yaml.Node) schemaKind {
+	if mappingValue(node, "oneOf") != nil {
+		return schemaOneOf
+	}
+	if mappingValue(node, "allOf") != nil {
+		return schemaAllOf
+	}
+	if mappingValue(node, "enum") != nil {
+		if scalarValue(node, "type") == "string" {
+			return schemaEnum
+		}
+		importer.warning(node, "enum of type %s is not supported, it is imported as plain type", scalarValue(node, "type"))
+		return schemaAlias
+	}
+	if mappingValue(node, "properties") != nil {
+		return schemaObject
+	}
+	if scalarValue(node, "type") == "object" && mappingValue(node, "additionalProperties") == nil {
+		return schemaObject
+	}
+	return schemaAlias
+}
+
+func (importer *importer) resolveSchema(node *yaml.Node) *yaml.Node {
+	ref := scalarValue(node, "$ref")
+	if ref == "" {
+		return node
+	}
+	if schema := importer.schemas[strings.TrimPrefix(ref, schemasRefPrefix)]; schema != nil {
+		return schema.Node
+	}
+	return node
+}
+
+func (importer *importer) typeName(node *yaml.Node) string {
+	if node == nil {
+		return spec.TypeJson
+	}
+	if ref := scalarValue(node, "$ref"); ref != "" {
+		return importer.refTypeName(node, ref)
+	}
+	if scalarValue(node, "nullable") == "true" {
+		return importer.plainTypeName(node) + "?"
+	}
+	return importer.plainTypeName(node)
+}
+
+func (importer *importer) refTypeName(node *yaml.Node, ref string) string {
+	if !strings.HasPrefix(ref, schemasRefPrefix) {
+		importer.warning(node, "reference %s is not supported, it is imported as json", ref)
+		return spec.TypeJson
+	}
+	schema := importer.schemas[strings.TrimPrefix(ref, schemasRefPrefix)]
+	if schema == nil {
+		importer.error(node, "unknown schema reference: %s", ref)
+		return spec.TypeJson
+	}
+	if schema.Kind != schemaAlias {
+		return schema.Model
+	}
+	if importer.resolving[schema.Name] {
+		importer.warning(node, "recursive schema %s is imported as json", schema.Name)
+		return spec.TypeJson
+	}
+	importer.resolving[schema.Name] = true
+	defer delete(importer.resolving, schema.Name)
+	return importer.typeName(schema.Node)
+}
+
+func (importer *importer) plainTypeName(node *yaml.Node) string {
+	for _, composition := range []string{"oneOf", "anyOf", "allOf"} {
+		if mappingValue(node, composition) != nil {
+			importer.warning(node, "inline %s schema is not supported, it is imported as json", composition)
+			return spec.TypeJson
+		}
+	}
+	typ := scalarValue(node, "type")
+	format := scalarValue(node, "format")
+	switch typ {
+	case "string":
+		if mappingValue(node, "enum") != nil {
+			importer.warning(node, "inline enum is not supported, it is imported as string")
+		}
+		switch format {
+		case "uuid":
+			return spec.TypeUuid
+		case "date":
+			return spec.TypeDate
+		case "date-time":
+			return spec.TypeDateTime
+		case "binary", "byte":
+			importer.warning(node, "string of format %s is imported as string", format)
+		}
+		return spec.TypeString
+	case "integer":
+		if format == "int64" {
+			return spec.TypeAliasLong
+		}
+		return spec.TypeAliasInt
+	case "number":
+		switch format {
+		case "float":
+			return spec.TypeFloat
+		case "decimal":
+			return spec.TypeDecimal
+		}
+		return spec.TypeDouble
+	case "boolean":
+		return spec.TypeAliasBool
+	case "array":
+		return importer.typeName(mappingValue(node, "items")) + "[]"
+	case "object":
+		if additional := mappingValue(node, "additionalProperties"); additional != nil && additional.Kind == yaml.MappingNode && len(additional.Content) > 0 {
+			return importer.typeName(additional) + "{}"
+		}
+		if mappingValue(node, "properties") != nil {
+			importer.warning(node, "inline object schema is not supported, it is imported as json")
+		}
+		return spec.TypeJson
+	case "":
+		return spec.TypeJson
+	default:
+		importer.warning(node, "schema type %s is not supported, it is imported as json", typ)
+		return spec.TypeJson
+	}
+}
+
+func (importer *importer) constraints(node *yaml.Node, typeName string) spec.Constraints {
+	node = importer.resolveSchema(node)
+	minKeyword, maxKeyword := "minimum", "maximum"
+	switch {
+	case strings.HasSuffix(strings.TrimSuffix(typeName, "?"), "[]"):
+		minKeyword, maxKeyword = "minItems", "maxItems"
+	case strings.TrimSuffix(typeName, "?") == spec.TypeString:
+		minKeyword, maxKeyword = "minLength", "maxLength"
+	}
+	constraints := spec.Constraints{}
+	if min := scalarValue(node, minKeyword); min != "" {
+		constraints = append(constraints, spec.Constraint{Name: spec.ConstraintMin, Value: min})
+	}
+	if max := scalarValue(node, maxKeyword); max != "" {
+		constraints = append(constraints, spec.Constraint{Name: spec.ConstraintMax, Value: max})
+	}
+	if pattern := scalarValue(node, "pattern"); pattern != "" && minKeyword == "minLength" {
+		constraints = append(constraints, spec.Constraint{Name: spec.ConstraintPattern, Value: pattern})
+	}
+	return constraints
+}
+
+func isBinarySchema(node *yaml.Node) bool {
+	return scalarValue(node, "type") == "string" && scalarValue(node, "format") == "binary"
+}
+
+func (importer *importer) definition(node *yaml.Node, required bool, withDefault bool) string {
+	typeName := importer.typeName(node)
+	definition := strings.TrimSuffix(typeName, "?") + importer.constraints(node, typeName).String()
+	defaultValue := importer.defaultValue(node)
+	if defaultValue != nil && withDefault {
+		return definition + " = " + *defaultValue
+	}
+	if defaultValue != nil {
+		importer.warning(node, "default value of model field is not supported and is skipped")
+	}
+	if !required || strings.HasSuffix(typeName, "?") {
+		definition += "?"
+	}
+	return definition
+}
+
+func (importer *importer) defaultValue(node *yaml.Node) *string {
+	defaultNode := mappingValue(importer.resolveSchema(node), "default")
+	if defaultNode == nil {
+		return nil
+	}
+	if defaultNode.Kind != yaml.ScalarNode {
+		importer.warning(defaultNode, "non scalar default value is not supported")
+		return nil
+	}
+	return &defaultNode.Value
+}
+
+func (importer *importer) models(names []string) *yamlx.YamlMap {
+	models := yamlx.Map()
+	for _, name := range names {
+		schema := importer.schemas[name]
+		var model *yamlx.YamlMap
+		switch schema.Kind {
+		case schemaObject:
+			model = importer.objectModel(schema.Node)
+		case schemaAllOf:
+			importer.warning(schema.Source, "allOf schema %s is flattened into object", name)
+			model = importer.objectModel(schema.Node)
+		case schemaEnum:
+			model = importer.enumModel(schema.Node)
+		case schemaOneOf:
+			model = importer.oneOfModel(schema.Node)
+		default:
+			continue
+		}
+		if description := scalarValue(schema.Node, "description"); description != "" {
+			model.Add("description", description)
+		}
+		models.Add(schema.Model, model)
+	}
+	return models
+}
+
+type property struct {
+	Name     *yaml.Node
+	Schema   *yaml.Node
+	Required bool
+}
+
+func (importer *importer) properties(node *yaml.Node) []property {
+	node = importer.resolveSchema(node)
+	properties := []property{}
+	for _, part := range sequenceItems(node, "allOf") {
+		properties = append(properties, importer.properties(part)...)
+	}
+	required := []string{}
+	for _, item := range sequenceItems(node, "required") {
+		required = append(required, item.Value)
+	}
+	for _, pair := range mappingPairs(mappingValue(node, "properties")) {
+		properties = append(properties, property{pair.Key, pair.Value, containsString(required, pair.Key.Value)})
+	}
+	return properties
+}
+
+func (importer *importer) objectModel(node *yaml.Node) *yamlx.YamlMap {
+	fields := yamlx.Map()
+	for _, property := range importer.properties(node) {
+		fields.AddWithComment(property.Name.Value, importer.definition(property.Schema, property.Required, false), descriptionComment(importer.resolveSchema(property.Schema)))
+	}
+	return singleMap("object", fields)
+}
+
+func (importer *importer) enumModel(node *yaml.Node) *yamlx.YamlMap {
+	values := sequenceItems(node, "enum")
+	sameAsValues := true
+	for _, value := range values {
+		if spec.SnakeCase.Check(value.Value) != nil {
+			sameAsValues = false
+		}
+	}
+	if sameAsValues {
+		items := yamlx.Array()
+		for _, value := range values {
+			items.Add(value.Value)
+		}
+		return singleMap("enum", items)
+	}
+	items := yamlx.Map()
+	for _, value := range values {
+		items.Add(snakeName(value.Value), value.Value)
+	}
+	return singleMap("enum", items)
+}
+
+func (importer *importer) oneOfModel(node *yaml.Node) *yamlx.YamlMap {
+	items := yamlx.Map()
+	discriminator := mappingValue(node, "discriminator")
+	mapping := map[string]string{}
+	for _, pair := range mappingPairs(mappingValue(discriminator, "mapping")) {
+		mapping[pair.Value.Value] = pair.Key.Value
+	}
+	for _, item := range sequenceItems(node, "oneOf") {
+		if ref := scalarValue(item, "$ref"); ref != "" {
+			if discriminator == nil {
+				importer.warning(item, "oneOf item %s without discriminator is imported as wrapper case", ref)
+			}
+			caseName, found := mapping[ref]
+			if !found {
+				caseName = snakeName(strings.TrimPrefix(ref, schemasRefPrefix))
+			}
+			items.Add(caseName, importer.typeName(item))
+			continue
+		}
+		wrapped := mappingPairs(mappingValue(item, "properties"))
+		if discriminator == nil && len(wrapped) == 1 {
+			items.AddWithComment(wrapped[0].Key.Value, importer.typeName(wrapped[0].Value), descriptionComment(item))
+			continue
+		}
+		importer.warning(item, "inline oneOf item is not supported and is skipped")
+	}
+	model := yamlx.Map()
+	if discriminator != nil {
+		model.Add("discriminator", scalarValue(discriminator, "propertyName"))
+	}
+	model.Add("oneOf", items)
+	return model
+}
+
+func (importer *importer) schemaRefs(node *yaml.Node, refs map[string]bool) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.MappingNode {
+		if ref := scalarValue(node, "$ref"); strings.HasPrefix(ref, schemasRefPrefix) {
+			name := strings.TrimPrefix(ref, schemasRefPrefix)
+			if !refs[name] {
+				refs[name] = true
+				if schema := importer.schemas[name]; schema != nil {
+					importer.schemaRefs(schema.Node, refs)
+				}
+			}
+		}
+	}
+	for _, child := range node.Content {
+		importer.schemaRefs(child, refs)
+	}
+}
+
+func descriptionComment(node *yaml.Node) *string {
+	description := scalarValue(node, "description")
+	if description == "" {
+		description = scalarValue(node, "summary")
+	}
+	if description == "" {
+		return nil
+	}
+	comment := fmt.Sprintf("# %s", strings.Join(strings.Fields(description), " "))
+	return &comment
+}
@@ -0,0 +1,62 @@
+package importopenapi
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/yamlx"
+	"gopkg.in/specgen-io/yaml.v3"
+)
+
+type pair struct {
+	Key   *yaml.Node
+	Value *yaml.Node
+}
+
+func mappingPairs(node *yaml.Node) []pair {
+	pairs := []pair{}
+	if node == nil || node.Kind != yaml.MappingNode {
+		return pairs
+	}
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		pairs = append(pairs, pair{node.Content[index], node.Content[index+1]})
+	}
+	return pairs
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for _, pair := range mappingPairs(node) {
+		if pair.Key.Value == key {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+func scalarValue(node *yaml.Node, key string) string {
+	value := mappingValue(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+func sequenceItems(node *yaml.Node, key string) []*yaml.Node {
+	value := mappingValue(node, key)
+	if value == nil || value.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return value.Content
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func singleMap(key string, value interface{}) *yamlx.YamlMap {
+	yamlMap := yamlx.Map()
+	yamlMap.Add(key, value)
+	return yamlMap
+}
@@ -3,6 +3,7 @@ package openapi
 import (
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"strings"
 )
 
 var Openapi = generator.Generator{
@@ -12,11 +13,31 @@ var Openapi = generator.Generator{
 	[]generator.GeneratorArg{
 		{Arg: generator.ArgSpecFile, Required: true},
 		{Arg: generator.ArgOutFile, Required: true},
+		{Arg: generator.ArgOpenapiVersion, Required: false, Values: OpenapiVersions, Default: OpenapiVersion30},
+		{Arg: generator.ArgFormat, Required: false, Values: Formats, Default: FormatYaml},
+		{Arg: generator.ArgServers, Required: false},
+		{Arg: generator.ArgSplitVersions, Required: false, Default: "false", Bool: true},
 	},
-	func(specification *spec.Spec, params generator.GeneratorArgsValues) *generator.Sources {
-		openapiFile := GenerateOpenapi(specification, params[generator.ArgOutFile])
+	func(specification *spec.Spec, params generator.GeneratorArgsValues) (*generator.Sources, error) {
+		options := Options{
+			params[generator.ArgOpenapiVersion],
+			params[generator.ArgFormat],
+			serverUrls(params[generator.ArgServers]),
+			params[generator.ArgSplitVersions] == "true",
+		}
 		sources := generator.NewSources()
-		sources.AddGenerated(openapiFile)
-		return sources
+		sources.AddGeneratedAll(GenerateOpenapiFiles(specification, params[generator.ArgOutFile], options))
+		return sources, nil
 	},
 }
+
+func serverUrls(servers string) []string {
+	urls := []string{}
+	for _, url := range strings.Split(servers, ",") {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/pinzolo/casee"
@@ -10,13 +11,46 @@ import (
 )
 
 func GenerateOpenapi(spec *spec.Spec, outFile string) *generator.CodeFile {
-	openapi := generateSpecification(spec)
+	return &GenerateOpenapiFiles(spec, outFile, DefaultOptions)[0]
+}
+
+func GenerateOpenapiFiles(specification *spec.Spec, outFile string, options Options) []generator.CodeFile {
+	files := []generator.CodeFile{}
+	if !options.SplitVersions {
+		openapi := generateDocument(specification, specification.Versions, options)
+		files = append(files, generator.CodeFile{outFile, serialize(openapi, options)})
+		return files
+	}
+	for index := range specification.Versions {
+		version := &specification.Versions[index]
+		openapi := generateDocument(specification, specification.Versions[index:index+1], options)
+		files = append(files, generator.CodeFile{versionedFileName(outFile, version), serialize(openapi, options)})
+	}
+	return files
+}
+
+func versionedFileName(outFile string, version *spec.Version) string {
+	if version.Name.Source == "" {
+		return outFile
+	}
+	ext := filepath.Ext(outFile)
+	return strings.TrimSuffix(outFile, ext) + "." + version.Name.Source + ext
+}
+
+func serialize(openapi *yamlx.YamlMap, options Options) string {
+	if options.Format == FormatJson {
+		data, _ := yamlx.ToJsonString(openapi)
+		return data
+	}
 	data, _ := yamlx.ToYamlString(openapi)
-	openapiFile := &generator.CodeFile{outFile, data}
-	return openapiFile
+	return data
 }
 
 func generateSpecification(specification *spec.Spec) *yamlx.YamlMap {
+	return generateDocument(specification, specification.Versions, DefaultOptions)
+}
+
+func generateDocument(specification *spec.Spec, versions []spec.Version, options Options) *yamlx.YamlMap {
 	info := yamlx.Map()
 	title := specification.Name.Source
 	if specification.Title != nil {
@@ -29,7 +63,7 @@ func generateSpecification(specification *spec.Spec) *yamlx.YamlMap {
 	info.Add("version", specification.Version)
 
 	schemas := yamlx.Map()
-	for _, version := range specification.Versions {
+	for _, version := range versions {
 		for _, model := range version.Models {
 			schemas.Merge(generateModel(&model).Node)
 		}
@@ -41,14 +75,27 @@ func generateSpecification(specification *spec.Spec) *yamlx.YamlMap {
 	}
 
 	components := yamlx.Map(yamlx.Pair{"schemas", schemas})
+	if len(specification.Security) > 0 {
+		components.Add("securitySchemes", generateSecuritySchemes(specification.Security))
+	}
 
 	openapi := yamlx.Map(
-		yamlx.Pair{"openapi", "3.0.0"},
+		yamlx.Pair{"openapi", openapiVersion(options.OpenapiVersion)},
 		yamlx.Pair{"info", info},
-		yamlx.Pair{"paths", generateApis(specification)},
-		yamlx.Pair{"components", components},
 	)
+	if len(options.Servers) > 0 {
+		servers := yamlx.Array()
+		for _, url := range options.Servers {
+			servers.Add(yamlx.Map(yamlx.Pair{"url", url}))
+		}
+		openapi.Add("servers", servers)
+	}
+	openapi.Add("paths", generateApis(versions))
+	openapi.Add("components", components)
 
+	if options.OpenapiVersion == OpenapiVersion31 {
+		convertToOpenapi31(&openapi.Node)
+	}
 	return openapi
 }
 
@@ -59,9 +106,9 @@ func versionedModelName(version *spec.Version, modelName string) string {
 	return modelName
 }
 
-func generateApis(spec *spec.Spec) *yamlx.YamlMap {
+func generateApis(versions []spec.Version) *yamlx.YamlMap {
 	paths := yamlx.Map()
-	groups := OperationsByUrl(spec)
+	groups := operationsByUrl(versions)
 	for _, group := range groups {
 		path := yamlx.Map()
 		for _, o := range group.Operations {
@@ -82,15 +129,8 @@ func generateOperation(o *spec.NamedOperation) *yamlx.YamlMap {
 	if o.Operation.Description != nil {
 		operation.Add("description", o.Operation.Description)
 	}
-	if o.Operation.Body.Type != nil {
-		body := o.Operation.Body
-		request := yamlx.Map()
-		if body.Description != nil {
-			request.Add("description", body.Description)
-		}
-		request.Add("required", !body.Type.Definition.IsNullable())
-		request.Add("content", generateJsonContent(&body.Type.Definition))
-		operation.Add("requestBody", request)
+	if !o.Operation.BodyIs(spec.RequestBodyEmpty) {
+		operation.Add("requestBody", generateRequestBody(o.Operation.Body))
 	}
 
 	parameters := yamlx.Array()
@@ -98,15 +138,53 @@ func generateOperation(o *spec.NamedOperation) *yamlx.YamlMap {
 	addParameters(parameters, "path", o.Operation.Endpoint.UrlParams)
 	addParameters(parameters, "header", o.Operation.HeaderParams)
 	addParameters(parameters, "query", o.Operation.QueryParams)
+	addParameters(parameters, "cookie", o.Operation.CookieParams)
 
 	if parameters.Length() > 0 {
 		operation.Add("parameters", parameters)
 	}
 
+	security := o.EffectiveSecurity()
+	if security != nil {
+		operation.Add("security", generateSecurity(security))
+	}
+
 	operation.Add("responses", generateResponses(o))
 	return operation
 }
 
+func generateSecuritySchemes(schemes spec.SecuritySchemes) *yamlx.YamlMap {
+	result := yamlx.Map()
+	for _, scheme := range schemes {
+		securityScheme := yamlx.Map()
+		switch scheme.Type {
+		case spec.SecurityBearer:
+			securityScheme.Add("type", "http")
+			securityScheme.Add("scheme", "bearer")
+		case spec.SecurityBasic:
+			securityScheme.Add("type", "http")
+			securityScheme.Add("scheme", "basic")
+		case spec.SecurityApiKey:
+			securityScheme.Add("type", "apiKey")
+			securityScheme.Add("in", scheme.In)
+			securityScheme.Add("name", scheme.KeyName)
+		}
+		if scheme.Description != nil {
+			securityScheme.Add("description", scheme.Description)
+		}
+		result.Add(scheme.Name.Source, securityScheme)
+	}
+	return result
+}
+
+func generateSecurity(security spec.Security) *yamlx.YamlArray {
+	result := yamlx.Array()
+	for _, ref := range security {
+		result.Add(yamlx.Map(yamlx.Pair{ref.Name.Source, ref.Args}))
+	}
+	return result
+}
+
 func addParameters(parameters *yamlx.YamlArray, in string, params []spec.NamedParam) {
 	for _, p := range params {
 		param := yamlx.Map()
@@ -114,6 +192,7 @@ func addParameters(parameters *yamlx.YamlArray, in string, params []spec.NamedPa
 		param.Add("name", p.Name.Source)
 		param.Add("required", !p.Type.Definition.IsNullable())
 		schema := OpenApiType(&p.Type.Definition)
+		addConstraints(schema, &p.Type.Definition, p.Constraints)
 		if p.Default != nil {
 			schema.AddRaw("default", *p.Default)
 		}
@@ -125,17 +204,93 @@ func addParameters(parameters *yamlx.YamlArray, in string, params []spec.NamedPa
 	}
 }
 
-func generateJsonContent(types ...*spec.TypeDef) *yamlx.YamlMap {
-	schema := yamlx.Map(yamlx.Pair{"schema", OpenApiType(types...)})
-	content := yamlx.Map(yamlx.Pair{"application/json", schema})
+func generateRequestBody(body *spec.RequestBody) *yamlx.YamlMap {
+	request := yamlx.Map()
+	if body.Description != nil {
+		request.Add("description", body.Description)
+	}
+	switch body.Kind() {
+	case spec.RequestBodyString, spec.RequestBodyJson, spec.RequestBodyBinary:
+		request.Add("required", !body.Type.Definition.IsNullable())
+		request.Add("content", generateContent(&body.Type.Definition))
+	case spec.RequestBodyFormData:
+		request.Add("required", true)
+		request.Add("content", yamlx.Map(yamlx.Pair{"multipart/form-data", generateFormSchema(spec.Params(body.FormData))}))
+	case spec.RequestBodyFormUrlEncoded:
+		request.Add("required", true)
+		request.Add("content", yamlx.Map(yamlx.Pair{"application/x-www-form-urlencoded", generateFormSchema(spec.Params(body.FormUrlEncoded))}))
+	}
+	return request
+}
+
+func generateFormSchema(params spec.Params) *yamlx.YamlMap {
+	schema := yamlx.Map()
+	schema.Add("type", "object")
+
+	required := yamlx.Array()
+	for _, p := range params {
+		if !p.Type.Definition.IsNullable() && p.Default == nil {
+			required.Add(p.Name.Source)
+		}
+	}
+	if required.Length() > 0 {
+		schema.Add("required", required)
+	}
+
+	properties := yamlx.Map()
+	for _, p := range params {
+		property := OpenApiType(&p.Type.Definition)
+		addConstraints(property, &p.Type.Definition, p.Constraints)
+		if p.Default != nil {
+			property.AddRaw("default", *p.Default)
+		}
+		if p.Description != nil {
+			property.Add("description", *p.Description)
+		}
+		properties.Add(p.Name.Source, property)
+	}
+	schema.Add("properties", properties)
+
+	return yamlx.Map(yamlx.Pair{"schema", schema})
+}
+
+func generateContent(types ...*spec.TypeDef) *yamlx.YamlMap {
+	content := yamlx.Map()
+	jsonTypes := []*spec.TypeDef{}
+	for _, typ := range types {
+		if typ.Plain == spec.TypeString {
+			content.Add("text/plain", yamlx.Map(yamlx.Pair{"schema", OpenApiType(typ)}))
+		} else if typ.IsFile() {
+			content.Add("application/octet-stream", yamlx.Map(yamlx.Pair{"schema", OpenApiType(typ)}))
+		} else {
+			jsonTypes = append(jsonTypes, typ)
+		}
+	}
+	if len(jsonTypes) > 0 {
+		content.Add("application/json", yamlx.Map(yamlx.Pair{"schema", OpenApiType(jsonTypes...)}))
+	}
 	return content
 }
 
+func generateStreamContent(body *spec.ResponseBody) *yamlx.YamlMap {
+	contentType := "application/x-ndjson"
+	if body.Is(spec.ResponseBodySse) {
+		contentType = "text/event-stream"
+	}
+	return yamlx.Map(yamlx.Pair{contentType, yamlx.Map(yamlx.Pair{"schema", OpenApiType(&body.Type.Definition)})})
+}
+
+const unauthorizedStatusCode = "401"
+const unauthorizedDescription = "Service will return this if credentials are missing or invalid"
+
 func generateResponses(operation *spec.NamedOperation) *yamlx.YamlMap {
 	statusCodes := operation.Responses.HttpStatusCodes()
 	if operation.InApi.InHttp.InVersion.InSpec.HttpErrors != nil {
 		statusCodes = spec.MergeHttpStatusCodes(statusCodes, operation.InApi.InHttp.InVersion.InSpec.HttpErrors.Responses.HttpStatusCodes())
 	}
+	if operation.IsSecured() {
+		statusCodes = spec.MergeHttpStatusCodes(statusCodes, []string{unauthorizedStatusCode})
+	}
 
 	result := yamlx.Map()
 	for _, statusCode := range statusCodes {
@@ -144,6 +299,11 @@ func generateResponses(operation *spec.NamedOperation) *yamlx.YamlMap {
 		var mainResponse *spec.Response = nil
 		var alternateResponse *spec.Response = nil
 
+		if response == nil && errorResponse == nil && statusCode == unauthorizedStatusCode {
+			result.Add(statusCode, yamlx.Map(yamlx.Pair{"description", unauthorizedDescription}))
+			continue
+		}
+
 		if response != nil {
 			mainResponse = &response.Response
 			if errorResponse != nil && response.Body.String() != errorResponse.Body.String() {
@@ -175,8 +335,29 @@ func generateResponse(response *spec.Response, alternate *spec.Response) *yamlx.
 		types = append(types, &alternate.Body.Type.Definition)
 	}
 
-	if len(types) > 0 {
-		result.Add("content", generateJsonContent(types...))
+	if response.Body.IsStream() {
+		result.Add("content", generateStreamContent(&response.Body))
+	} else if len(types) > 0 {
+		result.Add("content", generateContent(types...))
+	}
+	if response.HasHeaders() {
+		result.Add("headers", generateHeaders(response.Headers))
+	}
+	return result
+}
+
+func generateHeaders(params spec.HeaderParams) *yamlx.YamlMap {
+	result := yamlx.Map()
+	for _, p := range params {
+		header := yamlx.Map()
+		if p.Description != nil {
+			header.Add("description", *p.Description)
+		}
+		header.Add("required", !p.Type.Definition.IsNullable())
+		schema := OpenApiType(&p.Type.Definition)
+		addConstraints(schema, &p.Type.Definition, p.Constraints)
+		header.Add("schema", schema)
+		result.Add(p.Name.Source, header)
 	}
 	return result
 }
@@ -301,6 +482,7 @@ func generateObjectModel(model *spec.NamedModel) *yamlx.YamlMap {
 	properties := yamlx.Map()
 	for _, field := range model.Object.Fields {
 		property := OpenApiType(&field.Type.Definition)
+		addConstraints(property, &field.Type.Definition, field.Constraints)
 		if field.Description != nil {
 			property.Add("description", field.Description)
 		}
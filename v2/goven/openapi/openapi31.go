@@ -0,0 +1,134 @@
+package openapi
+
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+)
+
+// convertToOpenapi31 rewrites OpenAPI 3.0 schema keywords into their OpenAPI 3.1 (JSON Schema 2020-12) forms.
+func convertToOpenapi31(document *yaml.Node) {
+	if paths := mappingValue(document, "paths"); paths != nil {
+		convertSchemasIn(paths)
+	}
+	if schemas := mappingValue(mappingValue(document, "components"), "schemas"); schemas != nil {
+		for index := 1; index < len(schemas.Content); index += 2 {
+			convertSchema31(schemas.Content[index])
+		}
+	}
+}
+
+func convertSchemasIn(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for index := 0; index+1 < len(node.Content); index += 2 {
+			if node.Content[index].Value == "schema" {
+				convertSchema31(node.Content[index+1])
+			} else {
+				convertSchemasIn(node.Content[index+1])
+			}
+		}
+	}
+	if node.Kind == yaml.SequenceNode {
+		for _, item := range node.Content {
+			convertSchemasIn(item)
+		}
+	}
+}
+
+func convertSchema31(schema *yaml.Node) {
+	if schema == nil || schema.Kind != yaml.MappingNode {
+		return
+	}
+
+	if nullable := mappingValue(schema, "nullable"); nullable != nil {
+		removeMappingKey(schema, "nullable")
+		if typ := mappingValue(schema, "type"); nullable.Value == "true" && typ != nil && typ.Kind == yaml.ScalarNode {
+			*typ = yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle, Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: typ.Value},
+				{Kind: yaml.ScalarNode, Tag: "!!str", Style: yaml.DoubleQuotedStyle, Value: "null"},
+			}}
+		}
+	}
+
+	if format := mappingValue(schema, "format"); format != nil && format.Kind == yaml.ScalarNode {
+		switch format.Value {
+		case "binary":
+			renameMappingKey(schema, "format", "contentMediaType")
+			format.Value = "application/octet-stream"
+		case "byte":
+			renameMappingKey(schema, "format", "contentEncoding")
+			format.Value = "base64"
+		}
+	}
+
+	if example := mappingValue(schema, "example"); example != nil {
+		renameMappingKey(schema, "example", "examples")
+		*example = yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{copyNode(example)}}
+	}
+
+	convertExclusiveBound(schema, "exclusiveMinimum", "minimum")
+	convertExclusiveBound(schema, "exclusiveMaximum", "maximum")
+
+	if properties := mappingValue(schema, "properties"); properties != nil {
+		for index := 1; index < len(properties.Content); index += 2 {
+			convertSchema31(properties.Content[index])
+		}
+	}
+	convertSchema31(mappingValue(schema, "items"))
+	convertSchema31(mappingValue(schema, "additionalProperties"))
+	convertSchema31(mappingValue(schema, "not"))
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		if subschemas := mappingValue(schema, keyword); subschemas != nil {
+			for _, subschema := range subschemas.Content {
+				convertSchema31(subschema)
+			}
+		}
+	}
+}
+
+func convertExclusiveBound(schema *yaml.Node, exclusiveKeyword string, boundKeyword string) {
+	exclusive := mappingValue(schema, exclusiveKeyword)
+	if exclusive == nil || exclusive.Kind != yaml.ScalarNode || (exclusive.Value != "true" && exclusive.Value != "false") {
+		return
+	}
+	bound := mappingValue(schema, boundKeyword)
+	if exclusive.Value == "false" || bound == nil {
+		removeMappingKey(schema, exclusiveKeyword)
+		return
+	}
+	*exclusive = *copyNode(bound)
+	removeMappingKey(schema, boundKeyword)
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			return node.Content[index+1]
+		}
+	}
+	return nil
+}
+
+func removeMappingKey(node *yaml.Node, key string) {
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			node.Content = append(node.Content[:index], node.Content[index+2:]...)
+			return
+		}
+	}
+}
+
+func renameMappingKey(node *yaml.Node, key string, newKey string) {
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			node.Content[index].Value = newKey
+			return
+		}
+	}
+}
+
+func copyNode(node *yaml.Node) *yaml.Node {
+	copied := *node
+	return &copied
+}
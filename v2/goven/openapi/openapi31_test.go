@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/goven/yamlx"
+	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+func TestOpenapi31Files(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    upload_form:
+      endpoint: POST /upload_form
+      body:
+        form-data:
+          document: file
+      response:
+        ok: file
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.NilError(t, err)
+
+	expectedPathsYaml := `
+/upload_form:
+  post:
+    operationId: testUploadForm
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        multipart/form-data:
+          schema:
+            type: object
+            required:
+              - document
+            properties:
+              document:
+                type: string
+                contentMediaType: application/octet-stream
+    responses:
+      "200":
+        description: ""
+        content:
+          application/octet-stream:
+            schema:
+              type: string
+              contentMediaType: application/octet-stream
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	openapi := generateDocument(spec, spec.Versions, Options{OpenapiVersion31, FormatYaml, nil, false})
+	assert.Equal(t, openapi.Node.Content[1].Value, "3.1.0")
+	pathsYaml, err := yamlx.ToYamlString(mappingValue(&openapi.Node, "paths"))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestConvertSchema31(t *testing.T) {
+	schemaYaml := `
+type: object
+properties:
+  name:
+    type: string
+    nullable: true
+    example: Rex
+  age:
+    type: integer
+    minimum: 0
+    exclusiveMinimum: true
+    maximum: 150
+    exclusiveMaximum: false
+  tags:
+    type: array
+    items:
+      type: string
+      format: byte
+`
+	expectedYaml := `
+type: object
+properties:
+  name:
+    type: [string, "null"]
+    examples:
+      - Rex
+  age:
+    type: integer
+    exclusiveMinimum: 0
+    maximum: 150
+  tags:
+    type: array
+    items:
+      type: string
+      contentEncoding: base64
+`
+	document := yaml.Node{}
+	assert.NilError(t, yaml.Unmarshal([]byte(schemaYaml), &document))
+	schema := document.Content[0]
+	convertSchema31(schema)
+
+	convertedYaml, err := yamlx.ToYamlString(schema)
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedYaml), strings.TrimSpace(convertedYaml))
+}
@@ -115,6 +115,58 @@ components:
 	checkOpenApi(t, specYaml, expectedOpenApiYaml)
 }
 
+func TestObjectModelConstraints(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+models:
+  Model:
+    object:
+      name: string(min=1,max=64,pattern=^[a-z]+$)
+      age: int(min=0,max=150)?
+      price: decimal(min=0.5)
+      tags: string[](max=10)
+`
+
+	expectedOpenApiYaml := `
+openapi: 3.0.0
+info:
+  title: bla-api
+  version: ""
+paths: {}
+components:
+  schemas:
+    Model:
+      type: object
+      required:
+        - name
+        - price
+        - tags
+      properties:
+        name:
+          type: string
+          minLength: 1
+          maxLength: 64
+          pattern: ^[a-z]+$
+        age:
+          type: integer
+          format: int32
+          minimum: 0
+          maximum: 150
+        price:
+          type: number
+          format: decimal
+          minimum: 0.5
+        tags:
+          type: array
+          items:
+            type: string
+          maxItems: 10
+`
+
+	checkOpenApi(t, specYaml, expectedOpenApiYaml)
+}
+
 func TestOneOfWrapperModel(t *testing.T) {
 	specYaml := `
 spec: 2.1
@@ -504,3 +556,619 @@ components:
 
 	checkOpenApi(t, specYaml, expectedOpenApiYaml)
 }
+
+func TestSecurity(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+security:
+  bearer_auth: bearer
+  api_key:
+    type: api-key
+    in: header
+    name: X-Api-Key
+http:
+  security:
+    - bearer_auth
+  test:
+    secured:
+      endpoint: GET /secured
+      response:
+        ok: empty
+    scoped:
+      endpoint: GET /scoped
+      security:
+        - api_key
+        - bearer_auth: [read]
+      response:
+        ok: empty
+    public:
+      endpoint: GET /public
+      security: []
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedSchemesYaml := `
+bearer_auth:
+  type: http
+  scheme: bearer
+api_key:
+  type: apiKey
+  in: header
+  name: X-Api-Key
+`
+	schemesYaml, err := yamlx.ToYamlString(generateSecuritySchemes(spec.Security))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedSchemesYaml), strings.TrimSpace(schemesYaml))
+
+	operations := spec.Versions[0].Http.Apis[0].Operations
+	expectedSecurity := []string{
+		`- bearer_auth: []`,
+		"- api_key: []\n- bearer_auth:\n    - read",
+		`[]`,
+	}
+	for index, expected := range expectedSecurity {
+		securityYaml, err := yamlx.ToYamlString(generateSecurity(operations[index].EffectiveSecurity()))
+		assert.NilError(t, err)
+		assert.Equal(t, expected, strings.TrimSpace(securityYaml))
+	}
+}
+
+func TestSecurityUnauthorizedResponse(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+security:
+  bearer_auth: bearer
+http:
+  security:
+    - bearer_auth
+  test:
+    secured:
+      endpoint: GET /secured
+      response:
+        ok: empty
+    public:
+      endpoint: GET /public
+      security: []
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.NilError(t, err)
+
+	operations := spec.Versions[0].Http.Apis[0].Operations
+
+	expectedSecured := `
+"200":
+  description: ""
+"400":
+  description: Service will return this if parameters are not provided or couldn't be parsed correctly
+  content:
+    application/json:
+      schema:
+        $ref: '#/components/schemas/BadRequestError'
+"401":
+  description: Service will return this if credentials are missing or invalid
+"404":
+  description: Service will return this if the endpoint is not found
+  content:
+    application/json:
+      schema:
+        $ref: '#/components/schemas/NotFoundError'
+"500":
+  description: Service will return this if unexpected internal error happens
+  content:
+    application/json:
+      schema:
+        $ref: '#/components/schemas/InternalServerError'
+`
+	securedYaml, err := yamlx.ToYamlString(generateResponses(&operations[0]))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedSecured), strings.TrimSpace(securedYaml))
+
+	publicYaml, err := yamlx.ToYamlString(generateResponses(&operations[1]))
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(publicYaml, `"401"`))
+}
+
+func TestMethods(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    update:
+      endpoint: PATCH /some/url
+      body: string
+      response:
+        ok: empty
+    check:
+      endpoint: HEAD /some/url
+      response:
+        ok: empty
+    allowed:
+      endpoint: OPTIONS /some/url
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/some/url:
+  patch:
+    operationId: testUpdate
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        text/plain:
+          schema:
+            type: string
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+  head:
+    operationId: testCheck
+    tags:
+      - test
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+  options:
+    operationId: testAllowed
+    tags:
+      - test
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestRequestBodies(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    form_data:
+      endpoint: POST /form-data
+      body:
+        form-data:
+          name: string   # the name
+          size: int = 10
+          note: string?
+      response:
+        ok: string
+    form_urlencoded:
+      endpoint: POST /form-urlencoded
+      body:
+        form-urlencoded:
+          id: uuid
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/form-data:
+  post:
+    operationId: testFormData
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        multipart/form-data:
+          schema:
+            type: object
+            required:
+              - name
+            properties:
+              name:
+                type: string
+                description: the name
+              size:
+                type: integer
+                format: int32
+                default: 10
+              note:
+                type: string
+    responses:
+      "200":
+        description: ""
+        content:
+          text/plain:
+            schema:
+              type: string
+{{ global errors }}
+/form-urlencoded:
+  post:
+    operationId: testFormUrlencoded
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        application/x-www-form-urlencoded:
+          schema:
+            type: object
+            required:
+              - id
+            properties:
+              id:
+                type: string
+                format: uuid
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestResponseHeaders(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    create:
+      endpoint: POST /create
+      response:
+        created:
+          body: string
+          header:
+            Location: string   # where it is
+            Retry-After: int?
+            Set-Cookie: string[]
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/create:
+  post:
+    operationId: testCreate
+    tags:
+      - test
+    responses:
+      "201":
+        description: ""
+        content:
+          text/plain:
+            schema:
+              type: string
+        headers:
+          Location:
+            description: where it is
+            required: true
+            schema:
+              type: string
+          Retry-After:
+            required: false
+            schema:
+              type: integer
+              format: int32
+          Set-Cookie:
+            required: true
+            schema:
+              type: array
+              items:
+                type: string
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestCookieParams(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    session:
+      endpoint: GET /session
+      cookie:
+        session_id: string   # the session
+        page: int = 1
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/session:
+  get:
+    operationId: testSession
+    tags:
+      - test
+    parameters:
+      - in: cookie
+        name: session_id
+        required: true
+        schema:
+          type: string
+        description: the session
+      - in: cookie
+        name: page
+        required: true
+        schema:
+          type: integer
+          format: int32
+          default: 1
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestFiles(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    upload:
+      endpoint: POST /upload
+      body: file
+      response:
+        ok: file
+    upload_form:
+      endpoint: POST /upload_form
+      body:
+        form-data:
+          document: file
+          note: file?
+      response:
+        ok: empty
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/upload:
+  post:
+    operationId: testUpload
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        application/octet-stream:
+          schema:
+            type: string
+            format: binary
+    responses:
+      "200":
+        description: ""
+        content:
+          application/octet-stream:
+            schema:
+              type: string
+              format: binary
+{{ global errors }}
+/upload_form:
+  post:
+    operationId: testUploadForm
+    tags:
+      - test
+    requestBody:
+      required: true
+      content:
+        multipart/form-data:
+          schema:
+            type: object
+            required:
+              - document
+            properties:
+              document:
+                type: string
+                format: binary
+              note:
+                type: string
+                format: binary
+    responses:
+      "200":
+        description: ""
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestStreams(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+http:
+  test:
+    export:
+      endpoint: GET /export
+      response:
+        ok: stream<Item>
+    notifications:
+      endpoint: GET /notifications
+      response:
+        ok: sse<Item>
+models:
+  Item:
+    object:
+      field: string
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.Equal(t, err, nil)
+
+	expectedPathsYaml := `
+/export:
+  get:
+    operationId: testExport
+    tags:
+      - test
+    responses:
+      "200":
+        description: ""
+        content:
+          application/x-ndjson:
+            schema:
+              $ref: '#/components/schemas/Item'
+{{ global errors }}
+/notifications:
+  get:
+    operationId: testNotifications
+    tags:
+      - test
+    responses:
+      "200":
+        description: ""
+        content:
+          text/event-stream:
+            schema:
+              $ref: '#/components/schemas/Item'
+{{ global errors }}
+`
+	globalErrors := strings.TrimSpace(strings.Replace(openapiGlobalErrors, "\n  ", "\n", -1))
+	expectedPathsYaml = strings.Replace(expectedPathsYaml, `{{ global errors }}`, "      "+globalErrors, -1)
+
+	pathsYaml, err := yamlx.ToYamlString(generateApis(spec.Versions))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(expectedPathsYaml), strings.TrimSpace(pathsYaml))
+}
+
+func TestOptions(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+version: 0
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.NilError(t, err)
+
+	options := Options{OpenapiVersion31, FormatJson, []string{"https://api.example.com", "http://localhost:8080"}, false}
+	files := GenerateOpenapiFiles(spec, "openapi.json", options)
+
+	expectedOpenApiJson := `
+{
+  "openapi": "3.1.0",
+  "info": {
+    "title": "bla-api",
+    "version": "0"
+  },
+  "servers": [
+    {
+      "url": "https://api.example.com"
+    },
+    {
+      "url": "http://localhost:8080"
+    }
+  ],
+  "paths": {},
+  "components": {
+    "schemas": {}
+  }
+}
+`
+	assert.Equal(t, len(files), 1)
+	assert.Equal(t, files[0].Path, "openapi.json")
+	assert.Equal(t, files[0].Content, strings.TrimLeft(expectedOpenApiJson, "\n"))
+}
+
+func TestSplitVersions(t *testing.T) {
+	specYaml := `
+spec: 2.1
+name: bla-api
+version: 0
+
+v2:
+  models:
+    Message:
+      object:
+        prop1: string
+
+models:
+  Message:
+    object:
+      prop1: int
+`
+	spec, _, err := spec.ReadSpec([]byte(specYaml))
+	assert.NilError(t, err)
+
+	options := Options{OpenapiVersion30, FormatYaml, nil, true}
+	files := GenerateOpenapiFiles(spec, "docs/openapi.yaml", options)
+
+	expectedV2 := `
+openapi: 3.0.0
+info:
+  title: bla-api
+  version: "0"
+paths: {}
+components:
+  schemas:
+    v2.Message:
+      type: object
+      required:
+        - prop1
+      properties:
+        prop1:
+          type: string
+`
+	expectedDefault := `
+openapi: 3.0.0
+info:
+  title: bla-api
+  version: "0"
+paths: {}
+components:
+  schemas:
+    Message:
+      type: object
+      required:
+        - prop1
+      properties:
+        prop1:
+          type: integer
+          format: int32
+`
+	assert.Equal(t, len(files), 2)
+	assert.Equal(t, files[0].Path, "docs/openapi.v2.yaml")
+	assert.Equal(t, strings.TrimSpace(files[0].Content), strings.TrimSpace(expectedV2))
+	assert.Equal(t, files[1].Path, "docs/openapi.yaml")
+	assert.Equal(t, strings.TrimSpace(files[1].Content), strings.TrimSpace(expectedDefault))
+}
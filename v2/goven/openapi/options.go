@@ -0,0 +1,31 @@
+package openapi
+
+const (
+	OpenapiVersion30 = "3.0"
+	OpenapiVersion31 = "3.1"
+)
+
+var OpenapiVersions = []string{OpenapiVersion30, OpenapiVersion31}
+
+const (
+	FormatYaml = "yaml"
+	FormatJson = "json"
+)
+
+var Formats = []string{FormatYaml, FormatJson}
+
+type Options struct {
+	OpenapiVersion string
+	Format         string
+	Servers        []string
+	SplitVersions  bool
+}
+
+var DefaultOptions = Options{OpenapiVersion30, FormatYaml, nil, false}
+
+func openapiVersion(version string) string {
+	if version == OpenapiVersion31 {
+		return "3.1.0"
+	}
+	return "3.0.0"
+}
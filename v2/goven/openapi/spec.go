@@ -8,10 +8,14 @@ type UrlOperations struct {
 }
 
 func OperationsByUrl(specification *spec.Spec) []*UrlOperations {
+	return operationsByUrl(specification.Versions)
+}
+
+func operationsByUrl(versions []spec.Version) []*UrlOperations {
 	groups := make([]*UrlOperations, 0)
 	groupsMap := make(map[string]*UrlOperations)
-	for verIndex := range specification.Versions {
-		version := &specification.Versions[verIndex]
+	for verIndex := range versions {
+		version := &versions[verIndex]
 		for apiIndex := range version.Http.Apis {
 			api := &version.Http.Apis[apiIndex]
 			for opIndex := range api.Operations {
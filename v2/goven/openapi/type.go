@@ -43,6 +43,25 @@ func OpenApiType(types ...*spec.TypeDef) *yamlx.YamlMap {
 
 }
 
+func addConstraints(schema *yamlx.YamlMap, typ *spec.TypeDef, constraints spec.Constraints) {
+	minKeyword, maxKeyword := "minimum", "maximum"
+	baseType := typ.BaseType()
+	if baseType.Node == spec.ArrayType {
+		minKeyword, maxKeyword = "minItems", "maxItems"
+	} else if baseType.Plain == spec.TypeString {
+		minKeyword, maxKeyword = "minLength", "maxLength"
+	}
+	if min := constraints.Min(); min != nil {
+		schema.AddRaw(minKeyword, *min)
+	}
+	if max := constraints.Max(); max != nil {
+		schema.AddRaw(maxKeyword, *max)
+	}
+	if pattern := constraints.Pattern(); pattern != nil {
+		schema.Add("pattern", *pattern)
+	}
+}
+
 func PlainOpenApiType(typeInfo *spec.TypeInfo, typ string) *yamlx.YamlMap {
 	switch typ {
 	case spec.TypeInt32:
@@ -93,6 +112,11 @@ func PlainOpenApiType(typeInfo *spec.TypeInfo, typ string) *yamlx.YamlMap {
 		result.Add("type", "string")
 		result.Add("format", "date-time")
 		return result
+	case spec.TypeFile:
+		result := yamlx.Map()
+		result.Add("type", "string")
+		result.Add("format", "binary")
+		return result
 	case spec.TypeJson:
 		result := yamlx.Map()
 		result.Add("type", "object")
@@ -7,12 +7,14 @@ import (
 
 type Api struct {
 	Name       Name
+	Security   Security
 	Operations Operations
 	InHttp     *Http
 }
 
 type Http struct {
 	Url       *string
+	Security  Security
 	Apis      []Api
 	InVersion *Version
 }
@@ -37,11 +39,20 @@ func (value *Http) UnmarshalYAML(node *yaml.Node) error {
 		return err
 	}
 
+	var security Security = nil
+	securityNode := getMappingValue(node, "security")
+	if securityNode != nil {
+		err := securityNode.DecodeWith(decodeStrict, &security)
+		if err != nil {
+			return err
+		}
+	}
+
 	count := len(node.Content) / 2
 	array := []Api{}
 	for index := 0; index < count; index++ {
 		keyNode := node.Content[index*2]
-		if !contains([]string{"url"}, keyNode) {
+		if !contains([]string{"url", "security"}, keyNode) {
 			valueNode := node.Content[index*2+1]
 			name := Name{}
 			err := keyNode.DecodeWith(decodeStrict, &name)
@@ -52,25 +63,45 @@ func (value *Http) UnmarshalYAML(node *yaml.Node) error {
 			if err != nil {
 				return err
 			}
+			var apiSecurity Security = nil
+			apiSecurityNode := getMappingValue(valueNode, "security")
+			if apiSecurityNode != nil {
+				err = apiSecurityNode.DecodeWith(decodeStrict, &apiSecurity)
+				if err != nil {
+					return err
+				}
+			}
 			operations := Operations{}
 			err = valueNode.DecodeWith(decodeStrict, &operations)
 			if err != nil {
 				return err
 			}
-			array = append(array, Api{Name: name, Operations: operations})
+			array = append(array, Api{Name: name, Security: apiSecurity, Operations: operations})
 		}
 	}
 
-	*value = Http{Url: url, Apis: array}
+	*value = Http{Url: url, Security: security, Apis: array}
 	return nil
 }
 
 func (value Http) MarshalYAML() (interface{}, error) {
 	yamlMap := yamlx.Map()
 	yamlMap.AddOmitNil("url", value.Url)
+	if value.Security != nil {
+		yamlMap.Add("security", value.Security)
+	}
 	for index := 0; index < len(value.Apis); index++ {
 		api := value.Apis[index]
-		yamlMap.Add(api.Name, api.Operations)
+		yamlMap.Add(api.Name, api)
+	}
+	return yamlMap.Node, nil
+}
+
+func (value Api) MarshalYAML() (interface{}, error) {
+	yamlMap := yamlx.Map()
+	if value.Security != nil {
+		yamlMap.Add("security", value.Security)
 	}
+	yamlMap.Merge(value.Operations)
 	return yamlMap.Node, nil
 }
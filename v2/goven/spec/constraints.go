@@ -0,0 +1,173 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	ConstraintMin     string = "min"
+	ConstraintMax     string = "max"
+	ConstraintPattern string = "pattern"
+)
+
+var constraintsNames = []string{ConstraintMin, ConstraintMax, ConstraintPattern}
+
+type Constraint struct {
+	Name  string
+	Value string
+}
+
+type Constraints []Constraint
+
+func (constraints Constraints) Get(name string) *string {
+	for _, constraint := range constraints {
+		if constraint.Name == name {
+			value := constraint.Value
+			return &value
+		}
+	}
+	return nil
+}
+
+func (constraints Constraints) Min() *string {
+	return constraints.Get(ConstraintMin)
+}
+
+func (constraints Constraints) Max() *string {
+	return constraints.Get(ConstraintMax)
+}
+
+func (constraints Constraints) Pattern() *string {
+	return constraints.Get(ConstraintPattern)
+}
+
+func (constraints Constraints) String() string {
+	if len(constraints) == 0 {
+		return ""
+	}
+	items := []string{}
+	for _, constraint := range constraints {
+		value := constraint.Value
+		if strings.ContainsAny(value, ",()") {
+			value = `"` + value + `"`
+		}
+		items = append(items, constraint.Name+"="+value)
+	}
+	return "(" + strings.Join(items, ",") + ")"
+}
+
+func typeWithConstraints(typ *TypeDef, constraints Constraints) string {
+	if len(constraints) > 0 && typ.IsNullable() {
+		return typ.Child.String() + constraints.String() + "?"
+	}
+	return typ.String() + constraints.String()
+}
+
+func splitConstraints(str string) (string, string, string, error) {
+	start := strings.Index(str, "(")
+	defaultStart := strings.Index(str, "=")
+	if start < 0 || (defaultStart >= 0 && defaultStart < start) {
+		return str, "", "", nil
+	}
+	depth := 0
+	quoted := false
+	for index := start; index < len(str); index++ {
+		switch str[index] {
+		case '\\':
+			index++
+		case '"':
+			quoted = !quoted
+		case '(':
+			if !quoted {
+				depth++
+			}
+		case ')':
+			if !quoted {
+				depth--
+				if depth == 0 {
+					return str[:start], str[start+1 : index], str[index+1:], nil
+				}
+			}
+		}
+	}
+	return "", "", "", errors.New("constraints are not closed with ')'")
+}
+
+func parseConstraints(str string) (Constraints, error) {
+	constraints := Constraints{}
+	items := []string{}
+	depth := 0
+	quoted := false
+	itemStart := 0
+	for index := 0; index < len(str); index++ {
+		switch str[index] {
+		case '\\':
+			index++
+		case '"':
+			quoted = !quoted
+		case '(', '[', '{':
+			if !quoted {
+				depth++
+			}
+		case ')', ']', '}':
+			if !quoted {
+				depth--
+			}
+		case ',':
+			if !quoted && depth == 0 {
+				items = append(items, str[itemStart:index])
+				itemStart = index + 1
+			}
+		}
+	}
+	items = append(items, str[itemStart:])
+	for _, item := range items {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("constraint should be in format 'name=value', found: '%s'", strings.TrimSpace(item))
+		}
+		name := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if !containsString(constraintsNames, name) {
+			return nil, fmt.Errorf("unknown constraint: %s, supported constraints: %s", name, strings.Join(constraintsNames, ", "))
+		}
+		if constraints.Get(name) != nil {
+			return nil, fmt.Errorf("constraint %s is declared more than once", name)
+		}
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+		constraints = append(constraints, Constraint{name, value})
+	}
+	return constraints, nil
+}
+
+func parseConstrainedType(str string) (string, Constraints, string, error) {
+	typeStr, constraintsStr, rest, err := splitConstraints(str)
+	if err != nil {
+		return "", nil, "", err
+	}
+	if constraintsStr == "" && rest == "" {
+		return typeStr, nil, "", nil
+	}
+	constraints, err := parseConstraints(constraintsStr)
+	if err != nil {
+		return "", nil, "", err
+	}
+	if strings.HasPrefix(rest, "?") {
+		typeStr = typeStr + "?"
+		rest = rest[1:]
+	}
+	return strings.TrimSpace(typeStr), constraints, rest, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package spec
+
+import (
+	"errors"
+	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func Test_DefinitionDefault_Constraints_Unmarshal(t *testing.T) {
+	data := `string(min=1, max=64, pattern=^[a-z]+$)? = abc`
+	var definition DefinitionDefault
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &definition)
+	assert.Equal(t, err, nil)
+	assert.DeepEqual(t, definition.Type.Definition, ParseType("string?"))
+	assert.Equal(t, *definition.Default, "abc")
+	assert.Equal(t, *definition.Constraints.Min(), "1")
+	assert.Equal(t, *definition.Constraints.Max(), "64")
+	assert.Equal(t, *definition.Constraints.Pattern(), "^[a-z]+$")
+}
+
+func Test_DefinitionDefault_Constraints_Marshal(t *testing.T) {
+	checkUnmarshalMarshal(t, "string(min=1,pattern=^[a-z]+$)? = abc\n", &DefinitionDefault{})
+	checkUnmarshalMarshal(t, "int[](max=10)\n", &DefinitionDefault{})
+	checkUnmarshalMarshal(t, "string = (not constraints)\n", &DefinitionDefault{})
+}
+
+func Test_Definition_Constraints_QuotedPattern(t *testing.T) {
+	data := `string(pattern="^(a|b),c$", max=10)`
+	var definition Definition
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &definition)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, definition.Type.Definition, ParseType("string"))
+	assert.Equal(t, *definition.Constraints.Pattern(), "^(a|b),c$")
+	assert.Equal(t, *definition.Constraints.Max(), "10")
+	checkUnmarshalMarshal(t, "string(pattern=\"^(a|b),c$\",max=10)\n", &Definition{})
+}
+
+func Test_Definition_Constraints_Unknown(t *testing.T) {
+	var definition Definition
+	err := yaml.UnmarshalWith(decodeStrict, []byte(`string(length=10)`), &definition)
+	assert.ErrorContains(t, err, "unknown constraint: length")
+}
+
+func Test_Constraints(t *testing.T) {
+	runReadSpecificationCases(t, constraintsCases)
+}
+
+var constraintsCases = []ReadSpecificationCase{
+	{
+		`constraints no errors`,
+		`
+http:
+  test:
+    some_url:
+      endpoint: GET /some/url
+      query:
+        name: string(min=1,max=64)
+        limit: int(min=1,max=100) = 10
+        ids: long[](max=10)
+      response:
+        ok: Model
+models:
+  Model:
+    object:
+      price: decimal(min=0.5)
+      code: string(pattern=^[A-Z]{3}$)?
+`,
+		nil,
+		[]Message{},
+		nil,
+	},
+	{
+		`constraints not supported`,
+		`
+models:
+  Model:
+    object:
+      flag: bool(min=1)
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("type bool does not support constraints").At(&Location{specificationMetaLines + 4, 13})},
+		nil,
+	},
+	{
+		`constraints wrong values`,
+		`
+models:
+  Model:
+    object:
+      count: int(min=1.5)
+      tags: string[](pattern=^a$)
+      name: string(min=10,max=1)
+      size: string(min=-1)
+`,
+		errors.New("failed to validate specification"),
+		[]Message{
+			Error("constraint min format error: '1.5' is in wrong format, should be integer; examples: 123").At(&Location{specificationMetaLines + 4, 14}),
+			Error("constraint pattern is supported only for string type, found string[]").At(&Location{specificationMetaLines + 5, 13}),
+			Error("constraint min 10 is greater than constraint max 1").At(&Location{specificationMetaLines + 6, 13}),
+			Error("constraint min should not be negative for type string, found -1").At(&Location{specificationMetaLines + 7, 13}),
+		},
+		nil,
+	},
+}
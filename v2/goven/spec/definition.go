@@ -7,6 +7,7 @@ import (
 
 type DefinitionDefault struct {
 	Type        Type
+	Constraints Constraints
 	Default     *string
 	Description *string
 	Location    *yaml.Node
@@ -27,13 +28,18 @@ func (value *DefinitionDefault) UnmarshalYAML(node *yaml.Node) error {
 	if node.Kind != yaml.ScalarNode {
 		return yamlError(node, "definition with default has to be scalar value")
 	}
-	typeStr, defaultValue := parseDefaultedType(node.Value)
+	typeStr, constraints, rest, err := parseConstrainedType(node.Value)
+	if err != nil {
+		return yamlError(node, err.Error())
+	}
+	typeStr, defaultValue := parseDefaultedType(typeStr + rest)
 	typ, err := parseType(typeStr)
 	if err != nil {
 		return yamlError(node, err.Error())
 	}
 	internal := DefinitionDefault{
 		Type:        Type{*typ, node},
+		Constraints: constraints,
 		Default:     defaultValue,
 		Description: getDescriptionFromComment(node),
 		Location:    node,
@@ -43,7 +49,7 @@ func (value *DefinitionDefault) UnmarshalYAML(node *yaml.Node) error {
 }
 
 func (value DefinitionDefault) MarshalYAML() (interface{}, error) {
-	yamlValue := value.Type.Definition.String()
+	yamlValue := typeWithConstraints(&value.Type.Definition, value.Constraints)
 	if value.Default != nil {
 		yamlValue = yamlValue + " = " + *value.Default
 	}
@@ -59,6 +65,7 @@ func (value DefinitionDefault) MarshalYAML() (interface{}, error) {
 
 type Definition struct {
 	Type        Type
+	Constraints Constraints
 	Description *string
 	Location    *yaml.Node
 }
@@ -67,12 +74,17 @@ func (value *Definition) UnmarshalYAML(node *yaml.Node) error {
 	if node.Kind != yaml.ScalarNode {
 		return yamlError(node, "definition has to be scalar value")
 	}
-	typ, err := parseType(node.Value)
+	typeStr, constraints, rest, err := parseConstrainedType(node.Value)
+	if err != nil {
+		return yamlError(node, err.Error())
+	}
+	typ, err := parseType(typeStr + rest)
 	if err != nil {
 		return yamlError(node, err.Error())
 	}
 	parsed := Definition{
 		Type:        Type{*typ, node},
+		Constraints: constraints,
 		Description: getDescriptionFromComment(node),
 		Location:    node,
 	}
@@ -81,7 +93,7 @@ func (value *Definition) UnmarshalYAML(node *yaml.Node) error {
 }
 
 func (value Definition) MarshalYAML() (interface{}, error) {
-	yamlValue := value.Type.Definition.String()
+	yamlValue := typeWithConstraints(&value.Type.Definition, value.Constraints)
 	node := yaml.Node{
 		Kind:  yaml.ScalarNode,
 		Value: yamlValue,
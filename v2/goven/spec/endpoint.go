@@ -8,6 +8,16 @@ import (
 	"strings"
 )
 
+const (
+	MethodGet     = "GET"
+	MethodPost    = "POST"
+	MethodPut     = "PUT"
+	MethodDelete  = "DELETE"
+	MethodPatch   = "PATCH"
+	MethodHead    = "HEAD"
+	MethodOptions = "OPTIONS"
+)
+
 type UrlPart struct {
 	Part  string
 	Param *NamedParam
@@ -20,6 +30,14 @@ type Endpoint struct {
 	UrlParts  []UrlPart
 }
 
+func (value *Endpoint) AllowsRequestBody() bool {
+	return value.Method != MethodHead && value.Method != MethodOptions
+}
+
+func (value *Endpoint) AllowsResponseBody() bool {
+	return value.Method != MethodHead
+}
+
 func (value Endpoint) MarshalYAML() (interface{}, error) {
 	url := value.Url
 	for _, param := range value.UrlParams {
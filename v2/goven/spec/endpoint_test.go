@@ -103,3 +103,17 @@ func Test_Endpoint_EnumParam_Marshal(t *testing.T) {
 	var endpoint Endpoint
 	checkUnmarshalMarshal(t, expectedYaml, &endpoint)
 }
+
+func Test_ParseEndpoint_Methods(t *testing.T) {
+	for _, method := range []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS"} {
+		endpoint, err := parseEndpoint(method+" /some/url", nil)
+		assert.Equal(t, err == nil, true)
+		assert.Equal(t, endpoint.Method, method)
+	}
+}
+
+func Test_ParseEndpoint_MethodPrefix(t *testing.T) {
+	_, err := parseEndpoint("GETX /some/url", nil)
+	assert.Equal(t, err != nil, true)
+	assert.Equal(t, strings.Contains(err.Error(), "GETX"), true)
+}
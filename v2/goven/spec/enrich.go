@@ -12,15 +12,15 @@ func enrich(specification *Spec) (*Messages, error) {
 	if specification.HttpErrors != nil {
 		httpErrors := specification.HttpErrors
 		httpErrors.InSpec = specification
-		httpErrors.ResolvedModels = enrichModels(httpErrors.Models, messages)
+		httpErrors.ResolvedModels = enrichModels(httpErrors.Models, specification.files, messages)
 		errorModels := buildModelsMap(httpErrors.Models)
-		enricher := &httpEnricher{errorModels, messages}
+		enricher := &httpEnricher{errorModels, nil, specification.files, messages}
 		enricher.httpErrors(httpErrors)
 	}
 	for index := range specification.Versions {
 		version := &specification.Versions[index]
 		version.InSpec = specification
-		version.ResolvedModels = enrichModels(version.Models, messages)
+		version.ResolvedModels = enrichModels(version.Models, specification.files, messages)
 		models := buildModelsMap(version.Models)
 		if specification.HttpErrors != nil {
 			errorModels := buildModelsMap(specification.HttpErrors.Models)
@@ -28,7 +28,7 @@ func enrich(specification *Spec) (*Messages, error) {
 				models[name] = model
 			}
 		}
-		enricher := &httpEnricher{models, messages}
+		enricher := &httpEnricher{models, specification.Security, specification.files, messages}
 		enricher.version(version)
 	}
 	if messages.ContainsLevel(LevelError) {
@@ -40,16 +40,16 @@ func enrich(specification *Spec) (*Messages, error) {
 func addRequiredHttpErrors(specification *Spec, messages *Messages) {
 	if specification.HttpErrors != nil {
 		hasRequiredErrorModels :=
-			errorModelShouldNotBeDeclared(specification.HttpErrors, messages, BadRequestError) ||
-				errorModelShouldNotBeDeclared(specification.HttpErrors, messages, ValidationError) ||
-				errorModelShouldNotBeDeclared(specification.HttpErrors, messages, ErrorLocation) ||
-				errorModelShouldNotBeDeclared(specification.HttpErrors, messages, InternalServerError) ||
-				errorModelShouldNotBeDeclared(specification.HttpErrors, messages, NotFoundError)
+			errorModelShouldNotBeDeclared(specification, messages, BadRequestError) ||
+				errorModelShouldNotBeDeclared(specification, messages, ValidationError) ||
+				errorModelShouldNotBeDeclared(specification, messages, ErrorLocation) ||
+				errorModelShouldNotBeDeclared(specification, messages, InternalServerError) ||
+				errorModelShouldNotBeDeclared(specification, messages, NotFoundError)
 
 		hasRequiredErrorResponses :=
-			errorResponseShouldNotBeDeclared(specification.HttpErrors, messages, HttpStatusBadRequest) ||
-				errorResponseShouldNotBeDeclared(specification.HttpErrors, messages, HttpStatusNotFound) ||
-				errorResponseShouldNotBeDeclared(specification.HttpErrors, messages, HttpStatusInternalServerError)
+			errorResponseShouldNotBeDeclared(specification, messages, HttpStatusBadRequest) ||
+				errorResponseShouldNotBeDeclared(specification, messages, HttpStatusNotFound) ||
+				errorResponseShouldNotBeDeclared(specification, messages, HttpStatusInternalServerError)
 
 		if hasRequiredErrorModels || hasRequiredErrorResponses {
 			return
@@ -75,22 +75,22 @@ func addRequiredHttpErrors(specification *Spec, messages *Messages) {
 	}
 }
 
-func errorResponseShouldNotBeDeclared(httpErrors *HttpErrors, messages *Messages, httpStatusName string) bool {
-	if httpErrors.Responses != nil {
-		errorResponse := httpErrors.Responses.GetByStatusName(httpStatusName)
+func errorResponseShouldNotBeDeclared(specification *Spec, messages *Messages, httpStatusName string) bool {
+	if specification.HttpErrors.Responses != nil {
+		errorResponse := specification.HttpErrors.Responses.GetByStatusName(httpStatusName)
 		if errorResponse != nil {
-			messages.Add(Error(`error response '%s' is declared but should not`, httpStatusName).At(locationFromNode(errorResponse.Name.Location)))
+			messages.Add(specification.files.at(Error(`error response '%s' is declared but should not`, httpStatusName), errorResponse.Name.Location))
 			return true
 		}
 	}
 	return false
 }
 
-func errorModelShouldNotBeDeclared(httpErrors *HttpErrors, messages *Messages, name string) bool {
-	if httpErrors.Models != nil {
-		for _, model := range httpErrors.Models {
+func errorModelShouldNotBeDeclared(specification *Spec, messages *Messages, name string) bool {
+	if specification.HttpErrors.Models != nil {
+		for _, model := range specification.HttpErrors.Models {
 			if model.Name.Source == name {
-				messages.Add(Error(`error model '%s' is declared but should not`, name).At(locationFromNode(model.Location)))
+				messages.Add(specification.files.at(Error(`error model '%s' is declared but should not`, name), model.Location))
 				return true
 			}
 		}
@@ -110,8 +110,10 @@ func hasApis(specification *Spec) bool {
 }
 
 type httpEnricher struct {
-	models   ModelsMap
-	Messages *Messages
+	models          ModelsMap
+	securitySchemes SecuritySchemes
+	files           *specFiles
+	Messages        *Messages
 }
 
 func (enricher *httpEnricher) version(version *Version) {
@@ -121,10 +123,12 @@ func (enricher *httpEnricher) version(version *Version) {
 
 	http := &version.Http
 	http.InVersion = version
+	enricher.security(http.Security)
 
 	for apiIndex := range http.Apis {
 		api := &version.Http.Apis[apiIndex]
 		api.InHttp = http
+		enricher.security(api.Security)
 		for opIndex := range api.Operations {
 			operation := &api.Operations[opIndex]
 			operation.InApi = api
@@ -147,6 +151,8 @@ func (enricher *httpEnricher) operation(operation *NamedOperation) {
 	enricher.params(operation.Endpoint.UrlParams)
 	enricher.params(operation.QueryParams)
 	enricher.params(operation.HeaderParams)
+	enricher.params(operation.CookieParams)
+	enricher.security(operation.Security)
 
 	if operation.Body != nil {
 		enricher.requestBody(operation.Body)
@@ -167,6 +173,20 @@ func (enricher *httpEnricher) operation(operation *NamedOperation) {
 
 	for index := range operation.Responses {
 		enricher.responseBody(&operation.Responses[index].Body)
+		enricher.params(operation.Responses[index].Headers)
+	}
+}
+
+func (enricher *httpEnricher) security(security Security) {
+	for index := range security {
+		ref := &security[index]
+		scheme := enricher.securitySchemes.Get(ref.Name.Source)
+		if scheme != nil {
+			ref.Scheme = scheme
+		} else {
+			e := enricher.files.at(Error("unknown security scheme: %s", ref.Name.Source), ref.Location)
+			enricher.Messages.Add(e)
+		}
 	}
 }
 
@@ -218,7 +238,7 @@ func (enricher *httpEnricher) typeDef(starter *Type, typ *TypeDef) {
 				if info, found := Types[typ.Plain]; found {
 					typ.Info = &info
 				} else {
-					e := Error("unknown type: %s", typ.Plain).At(locationFromNode(starter.Location))
+					e := enricher.files.at(Error("unknown type: %s", typ.Plain), starter.Location)
 					enricher.Messages.Add(e)
 				}
 			}
@@ -256,6 +276,7 @@ ErrorLocation:
     - unknown
     - query
     - header
+    - cookie
     - body
 
 NotFoundError:
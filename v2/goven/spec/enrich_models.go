@@ -2,8 +2,8 @@ package spec
 
 import "fmt"
 
-func enrichModels(models Models, messages *Messages) []*NamedModel {
-	enricher := &modelsEnricher{buildModelsMap(models), make(map[string]bool), messages, nil}
+func enrichModels(models Models, files *specFiles, messages *Messages) []*NamedModel {
+	enricher := &modelsEnricher{buildModelsMap(models), make(map[string]bool), files, messages, nil}
 	for index := range models {
 		enricher.model(&models[index])
 	}
@@ -13,6 +13,7 @@ func enrichModels(models Models, messages *Messages) []*NamedModel {
 type modelsEnricher struct {
 	models        ModelsMap
 	visitedModels map[string]bool
+	files         *specFiles
 	messages      *Messages
 	orderedModels []*NamedModel
 }
@@ -51,7 +52,7 @@ func (enricher *modelsEnricher) typeDef(starter *Type, typ *TypeDef) {
 				if info, ok := Types[typ.Plain]; ok {
 					typ.Info = &info
 				} else {
-					e := Error("unknown type: %s", typ.Plain).At(locationFromNode(starter.Location))
+					e := enricher.files.at(Error("unknown type: %s", typ.Plain), starter.Location)
 					enricher.messages.Add(e)
 				}
 			}
@@ -1,8 +1,50 @@
 package spec
 
+import "github.com/specgen-io/specgen-golang/v2/goven/yamlx"
+
 type HttpErrors struct {
 	Responses      ErrorResponses `yaml:"responses"`
 	Models         Models         `yaml:"models"`
 	InSpec         *Spec
 	ResolvedModels []*NamedModel
 }
+
+func (value HttpErrors) MarshalYAML() (interface{}, error) {
+	yamlMap := yamlx.Map()
+	if len(value.Responses) > 0 {
+		yamlMap.Add("responses", value.Responses)
+	}
+	if len(value.Models) > 0 {
+		yamlMap.Add("models", value.Models)
+	}
+	return yamlMap.Node, nil
+}
+
+func (value *HttpErrors) declared() *HttpErrors {
+	if value == nil {
+		return nil
+	}
+	requiredResponses, _ := createErrorResponses()
+	requiredModels, _ := createErrorModels()
+	declared := HttpErrors{ErrorResponses{}, Models{}, nil, nil}
+	for _, response := range value.Responses {
+		if requiredResponses.GetByStatusName(response.Name.Source) == nil {
+			declared.Responses = append(declared.Responses, response)
+		}
+	}
+	for _, model := range value.Models {
+		required := false
+		for _, requiredModel := range requiredModels {
+			if requiredModel.Name.Source == model.Name.Source {
+				required = true
+			}
+		}
+		if !required {
+			declared.Models = append(declared.Models, model)
+		}
+	}
+	if len(declared.Responses) == 0 && len(declared.Models) == 0 {
+		return nil
+	}
+	return &declared
+}
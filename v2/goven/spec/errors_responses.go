@@ -85,7 +85,7 @@ func (value *ErrorResponses) UnmarshalYAML(node *yaml.Node) error {
 		if err != nil {
 			return err
 		}
-		response := Response{name, body, getDescriptionFromComment(valueNode)}
+		response := Response{name, body, nil, getDescriptionFromComment(valueNode)}
 		array[index] = ErrorResponse{response, false}
 	}
 	*value = array
@@ -38,7 +38,7 @@ var SnakeCase = Format{Name: "snake case", Regex: "^[a-z][a-z0-9]*(_[a-z][a-z0-9
 
 var LowerCase = Format{Name: "lower case", Regex: "^[a-z][a-z]*[0-9]*$", Example: "thisislowercase"}
 
-var HttpMethod = Format{Name: "HTTP method", Regex: "^GET|POST|PUT|DELETE$", Example: "GET, POST, PUT, DELETE"}
+var HttpMethod = Format{Name: "HTTP method", Regex: "^(GET|POST|PUT|DELETE|PATCH|HEAD|OPTIONS)$", Example: "GET, POST, PUT, DELETE, PATCH, HEAD, OPTIONS"}
 
 var Integer = Format{Name: "integer", Regex: "^[-+]?\\d+$", Example: "123"}
 
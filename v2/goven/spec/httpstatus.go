@@ -112,3 +112,12 @@ func MergeHttpStatusCodes(left, right []string) []string {
 	sort.Strings(results)
 	return results
 }
+
+func IsHttpStatusCode(statusCode string) bool {
+	for _, code := range httpStatusCode {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
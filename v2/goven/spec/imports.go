@@ -0,0 +1,268 @@
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"gopkg.in/specgen-io/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// specFiles keeps track of the file every node of the specification was read from,
+// this allows to report the originating file of any message produced while reading the specification.
+type specFiles struct {
+	baseDir   string
+	mainFile  string
+	nodeFiles map[*yaml.Node]string
+	imported  map[string]bool
+	stack     []string
+}
+
+func newSpecFiles(mainFile string) *specFiles {
+	if mainFile == "" {
+		return &specFiles{"", "", map[*yaml.Node]string{}, map[string]bool{}, []string{}}
+	}
+	mainFile = filepath.Clean(mainFile)
+	return &specFiles{filepath.Dir(mainFile), mainFile, map[*yaml.Node]string{}, map[string]bool{mainFile: true}, []string{mainFile}}
+}
+
+func (files *specFiles) paths() []string {
+	paths := []string{}
+	for path := range files.imported {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (files *specFiles) register(node *yaml.Node, file string) {
+	files.nodeFiles[node] = file
+	for _, child := range node.Content {
+		files.register(child, file)
+	}
+}
+
+func (files *specFiles) file(node *yaml.Node) string {
+	if node == nil || files == nil {
+		return ""
+	}
+	if file, found := files.nodeFiles[node]; found {
+		return file
+	}
+	return files.mainFile
+}
+
+// at places the message at the node in the file the node was read from.
+func (files *specFiles) at(message Message, node *yaml.Node) Message {
+	return message.At(locationFromNode(node)).In(files.file(node))
+}
+
+// locate attributes messages that were reported without a file, e.g. YAML decoding errors, to the main specification file.
+func (files *specFiles) locate(messages *Messages) {
+	for index := range messages.Items {
+		message := &messages.Items[index]
+		if message.Location != nil && message.File == "" {
+			message.File = files.mainFile
+		}
+	}
+}
+
+func parseSpecNode(data []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		return nil, errors.New("specification is empty")
+	}
+	return document.Content[0], nil
+}
+
+func importsPaths(node *yaml.Node) ([]*yaml.Node, error) {
+	importNode := getMappingValue(node, "import")
+	if importNode == nil {
+		return nil, nil
+	}
+	switch importNode.Kind {
+	case yaml.ScalarNode:
+		return []*yaml.Node{importNode}, nil
+	case yaml.SequenceNode:
+		for _, pathNode := range importNode.Content {
+			if pathNode.Kind != yaml.ScalarNode {
+				return nil, yamlError(pathNode, "import should be a file path")
+			}
+		}
+		return importNode.Content, nil
+	default:
+		return nil, yamlError(importNode, "import should be a file path or a list of file paths")
+	}
+}
+
+func removeMappingKey(mapping *yaml.Node, key string) {
+	for i := 0; i < len(mapping.Content)/2; i++ {
+		if mapping.Content[i*2].Value == key {
+			mapping.Content = append(mapping.Content[:i*2], mapping.Content[i*2+2:]...)
+			return
+		}
+	}
+}
+
+func (files *specFiles) resolveImports(node *yaml.Node, dir string) *Messages {
+	messages := NewMessages()
+	if node.Kind != yaml.MappingNode {
+		return messages
+	}
+	paths, err := importsPaths(node)
+	if err != nil {
+		messages.Add(convertYamlError(err, node).In(files.file(node)))
+		return messages
+	}
+	removeMappingKey(node, "import")
+	for _, pathNode := range paths {
+		path := filepath.Clean(filepath.Join(dir, pathNode.Value))
+		if containsString(files.stack, path) {
+			cycle := strings.Join(append(append([]string{}, files.stack...), path), " -> ")
+			messages.Add(files.at(Error("import cycle: %s", cycle), pathNode))
+			continue
+		}
+		if files.imported[path] {
+			continue
+		}
+		files.imported[path] = true
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			messages.Add(files.at(Error("failed to read imported file: %s", err.Error()), pathNode))
+			continue
+		}
+		importedNode, err := parseSpecNode(data)
+		if err != nil {
+			messages.Add(files.at(Error("failed to parse imported file %s: %s", path, err.Error()), pathNode))
+			continue
+		}
+		files.register(importedNode, path)
+		messages.AddAll(files.checkImported(importedNode, path).Items...)
+
+		files.stack = append(files.stack, path)
+		messages.AddAll(files.resolveImports(importedNode, filepath.Dir(path)).Items...)
+		files.stack = files.stack[:len(files.stack)-1]
+
+		messages.AddAll(files.mergeImported(node, importedNode).Items...)
+	}
+	return messages
+}
+
+// checkImported decodes sections of the imported file before other files are merged into it,
+// so decoding errors are reported with the imported file they come from.
+func (files *specFiles) checkImported(importedNode *yaml.Node, path string) *Messages {
+	messages := NewMessages()
+	if importedNode.Kind != yaml.MappingNode {
+		return messages
+	}
+	for i := 0; i < len(importedNode.Content)/2; i++ {
+		keyNode := importedNode.Content[i*2]
+		valueNode := importedNode.Content[i*2+1]
+		if keyNode.Value == "http" || keyNode.Value == "models" {
+			messages.AddAll(checkImportedSection(keyNode, valueNode, path).Items...)
+		} else if isVersionNode(keyNode) && valueNode.Kind == yaml.MappingNode {
+			for j := 0; j < len(valueNode.Content)/2; j++ {
+				messages.AddAll(checkImportedSection(valueNode.Content[j*2], valueNode.Content[j*2+1], path).Items...)
+			}
+		}
+	}
+	return messages
+}
+
+func checkImportedSection(keyNode *yaml.Node, valueNode *yaml.Node, path string) *Messages {
+	messages := NewMessages()
+	var err error
+	switch keyNode.Value {
+	case "http":
+		err = valueNode.DecodeWith(decodeStrict, &Http{})
+	case "models":
+		err = valueNode.DecodeWith(decodeStrict, &Models{})
+	}
+	if err != nil {
+		messages.Add(convertYamlError(err, valueNode).In(path))
+	}
+	return messages
+}
+
+func (files *specFiles) mergeImported(node *yaml.Node, importedNode *yaml.Node) *Messages {
+	messages := NewMessages()
+	if importedNode.Kind != yaml.MappingNode {
+		messages.Add(files.at(Error("imported file should be YAML mapping"), importedNode))
+		return messages
+	}
+	for i := 0; i < len(importedNode.Content)/2; i++ {
+		keyNode := importedNode.Content[i*2]
+		valueNode := importedNode.Content[i*2+1]
+		if keyNode.Value == "http" || keyNode.Value == "models" {
+			messages.AddAll(files.mergeSection(node, keyNode, valueNode).Items...)
+		} else if isVersionNode(keyNode) {
+			if valueNode.Kind != yaml.MappingNode {
+				messages.Add(files.at(Error("version %s should be YAML mapping", keyNode.Value), valueNode))
+				continue
+			}
+			versionNode := getMappingValue(node, keyNode.Value)
+			if versionNode == nil {
+				versionNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: keyNode.Line, Column: keyNode.Column}
+				versionKeyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keyNode.Value, Line: keyNode.Line, Column: keyNode.Column}
+				files.nodeFiles[versionNode] = files.nodeFiles[keyNode]
+				files.nodeFiles[versionKeyNode] = files.nodeFiles[keyNode]
+				node.Content = append(node.Content, versionKeyNode, versionNode)
+			}
+			for j := 0; j < len(valueNode.Content)/2; j++ {
+				sectionKeyNode := valueNode.Content[j*2]
+				if sectionKeyNode.Value != "http" && sectionKeyNode.Value != "models" {
+					messages.Add(files.at(Error("imported version %s can contain only http and models, found: %s", keyNode.Value, sectionKeyNode.Value), sectionKeyNode))
+					continue
+				}
+				messages.AddAll(files.mergeSection(versionNode, sectionKeyNode, valueNode.Content[j*2+1]).Items...)
+			}
+		} else {
+			messages.Add(files.at(Error("imported file can contain only import, http, models and versions, found: %s", keyNode.Value), keyNode))
+		}
+	}
+	return messages
+}
+
+func (files *specFiles) mergeSection(node *yaml.Node, keyNode *yaml.Node, valueNode *yaml.Node) *Messages {
+	messages := NewMessages()
+	if valueNode.Kind != yaml.MappingNode {
+		messages.Add(files.at(Error("%s should be YAML mapping", keyNode.Value), valueNode))
+		return messages
+	}
+	sectionNode := getMappingValue(node, keyNode.Value)
+	if sectionNode == nil {
+		sectionNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: valueNode.Line, Column: valueNode.Column}
+		files.nodeFiles[sectionNode] = files.nodeFiles[valueNode]
+		node.Content = append(node.Content, keyNode, sectionNode)
+	}
+	for i := 0; i < len(valueNode.Content)/2; i++ {
+		nameNode := valueNode.Content[i*2]
+		if keyNode.Value == "http" && (nameNode.Value == "url" || nameNode.Value == "security") {
+			messages.Add(files.at(Error("http %s can be declared only in the main specification file", nameNode.Value), nameNode))
+			continue
+		}
+		if existingNode := getMappingKey(sectionNode, nameNode.Value); existingNode != nil {
+			messages.Add(files.at(Error("duplicate name in %s: %s, already declared at %s", keyNode.Value, nameNode.Value, files.position(existingNode)), nameNode))
+			continue
+		}
+		sectionNode.Content = append(sectionNode.Content, nameNode, valueNode.Content[i*2+1])
+	}
+	return messages
+}
+
+func (files *specFiles) position(node *yaml.Node) string {
+	position := fmt.Sprintf("(%d, %d)", node.Line, node.Column)
+	if file := files.file(node); file != "" {
+		return file + " " + position
+	}
+	return position
+}
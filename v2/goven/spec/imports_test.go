@@ -0,0 +1,266 @@
+package spec
+
+import (
+	"gotest.tools/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSpecFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		assert.NilError(t, err)
+		err = os.WriteFile(path, []byte(strings.TrimLeft(content, "\n")), 0644)
+		assert.NilError(t, err)
+	}
+	return dir
+}
+
+const importsMainSpec = `
+spec: 2.1
+name: testing
+version: 1
+import:
+  - models.yaml
+  - apis/users.yaml
+http:
+  echo:
+    ping:
+      endpoint: GET /ping
+      response:
+        ok: empty
+`
+
+func Test_Imports(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": importsMainSpec,
+		"models.yaml": `
+models:
+  User:
+    object:
+      id: uuid
+      name: string
+`,
+		"apis/users.yaml": `
+import: ../common.yaml
+http:
+  users:
+    get_user:
+      endpoint: GET /users/{id:uuid}
+      response:
+        ok: User
+        created: Created
+`,
+		"common.yaml": `
+models:
+  Created:
+    object:
+      message: string
+`,
+	})
+
+	spec, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(messages.Items), 0)
+
+	version := spec.Versions[0]
+	assert.Equal(t, len(version.Http.Apis), 2)
+	assert.Equal(t, version.Http.Apis[0].Name.Source, "echo")
+	assert.Equal(t, version.Http.Apis[1].Name.Source, "users")
+	assert.Equal(t, len(version.Models), 2)
+	assert.Equal(t, version.Models[0].Name.Source, "User")
+	assert.Equal(t, version.Models[1].Name.Source, "Created")
+}
+
+func Test_Imports_Versions(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": `
+spec: 2.1
+name: testing
+version: 1
+import: v2.yaml
+models:
+  Message:
+    object:
+      field: string
+`,
+		"v2.yaml": `
+v2:
+  models:
+    Message:
+      object:
+        field: int
+`,
+	})
+
+	spec, _, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(spec.Versions), 2)
+	assert.Equal(t, spec.Versions[0].Name.Source, "v2")
+	assert.Equal(t, spec.Versions[0].Models[0].Name.Source, "Message")
+}
+
+func Test_Imports_DuplicateName(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": importsMainSpec + `
+models:
+  User:
+    object:
+      id: uuid
+`,
+		"models.yaml": `
+models:
+  User:
+    object:
+      id: uuid
+`,
+		"apis/users.yaml": `
+http:
+  echo:
+    pong:
+      endpoint: GET /pong
+      response:
+        ok: empty
+`,
+	})
+
+	_, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to read specification")
+	assertMessages(t, []Message{
+		Error("duplicate name in models: User, already declared at").At(&Location{2, 3}),
+		Error("duplicate name in http: echo, already declared at").At(&Location{2, 3}),
+	}, messages)
+	assert.Equal(t, messages.Items[0].File, filepath.Join(dir, "models.yaml"))
+	assert.Equal(t, messages.Items[1].File, filepath.Join(dir, "apis", "users.yaml"))
+}
+
+func Test_Imports_Cycle(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": `
+spec: 2.1
+name: testing
+version: 1
+import: a.yaml
+`,
+		"a.yaml": `
+import: b.yaml
+`,
+		"b.yaml": `
+import: a.yaml
+`,
+	})
+
+	_, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to read specification")
+	assert.Equal(t, len(messages.Items), 1)
+	message := messages.Items[0]
+	assert.Equal(t, strings.Contains(message.Message, "import cycle: "), true)
+	assert.Equal(t, strings.HasSuffix(message.Message, filepath.Join(dir, "a.yaml")+" -> "+filepath.Join(dir, "b.yaml")+" -> "+filepath.Join(dir, "a.yaml")), true)
+	assert.DeepEqual(t, message.Location, &Location{1, 9})
+	assert.Equal(t, message.File, filepath.Join(dir, "b.yaml"))
+}
+
+func Test_Imports_MessageLocation(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": `
+spec: 2.1
+name: testing
+version: 1
+import: models.yaml
+`,
+		"models.yaml": `
+models:
+  Model:
+    object:
+      field: Unknown
+`,
+	})
+
+	_, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to parse specification")
+	assert.Equal(t, len(messages.Items), 1)
+	assert.DeepEqual(t, messages.Items[0].Location, &Location{4, 14})
+	assert.Equal(t, messages.Items[0].File, filepath.Join(dir, "models.yaml"))
+}
+
+func Test_Imports_DecodeErrorLocation(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": `
+spec: 2.1
+name: testing
+version: 1
+import: models.yaml
+models:
+  Model:
+    object:
+      field: string
+`,
+		"models.yaml": `
+models:
+  Other:
+    object: [field]
+`,
+	})
+
+	_, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to read specification")
+	assert.Equal(t, len(messages.Items), 1)
+	assert.DeepEqual(t, messages.Items[0].Location, &Location{2, 3})
+	assert.Equal(t, messages.Items[0].File, filepath.Join(dir, "models.yaml"))
+}
+
+func Test_Imports_NotAllowedKey(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": `
+spec: 2.1
+name: testing
+version: 1
+import: models.yaml
+`,
+		"models.yaml": `
+errors:
+  responses:
+    conflict: empty
+`,
+	})
+
+	_, messages, err := ReadSpecFile(filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to read specification")
+	assertMessages(t, []Message{
+		Error("imported file can contain only import, http, models and versions, found: errors").At(&Location{1, 1}),
+	}, messages)
+}
+
+func Test_Imports_Paths(t *testing.T) {
+	dir := writeSpecFiles(t, map[string]string{
+		"spec.yaml": importsMainSpec,
+		"models.yaml": `
+models:
+  User:
+    object:
+      id: uuid
+`,
+		"apis/users.yaml": `
+import: ../missing.yaml
+http:
+  users:
+    get_user:
+      endpoint: GET /users/{id:uuid}
+      response:
+        ok: User
+`,
+	})
+
+	_, paths, _, err := ReadSpecFileWithImports(SpecOptionsDefault, filepath.Join(dir, "spec.yaml"))
+	assert.Error(t, err, "failed to read specification")
+	assert.DeepEqual(t, paths, []string{
+		filepath.Join(dir, "apis", "users.yaml"),
+		filepath.Join(dir, "missing.yaml"),
+		filepath.Join(dir, "models.yaml"),
+		filepath.Join(dir, "spec.yaml"),
+	})
+}
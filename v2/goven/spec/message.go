@@ -29,18 +29,19 @@ type Message struct {
 	Level    Level
 	Message  string
 	Location *Location
+	File     string
 }
 
 func Error(messageFormat string, args ...interface{}) Message {
-	return Message{LevelError, fmt.Sprintf(messageFormat, args...), nil}
+	return Message{LevelError, fmt.Sprintf(messageFormat, args...), nil, ""}
 }
 
 func Warning(messageFormat string, args ...interface{}) Message {
-	return Message{LevelWarning, fmt.Sprintf(messageFormat, args...), nil}
+	return Message{LevelWarning, fmt.Sprintf(messageFormat, args...), nil, ""}
 }
 
 func Info(messageFormat string, args ...interface{}) Message {
-	return Message{LevelInfo, fmt.Sprintf(messageFormat, args...), nil}
+	return Message{LevelInfo, fmt.Sprintf(messageFormat, args...), nil, ""}
 }
 
 func (message Message) At(location *Location) Message {
@@ -48,6 +49,11 @@ func (message Message) At(location *Location) Message {
 	return message
 }
 
+func (message Message) In(file string) Message {
+	message.File = file
+	return message
+}
+
 func convertYamlError(err error, node *yaml.Node) Message {
 	if yamlError, ok := err.(yaml.YamlError); ok {
 		if yamlError.Line != 0 {
@@ -107,6 +113,9 @@ func (ms messages) Less(i, j int) bool {
 		return false
 
 	}
+	if ms[i].File != ms[j].File {
+		return ms[i].File < ms[j].File
+	}
 	return ms[i].Location.Line < ms[j].Location.Line ||
 		(ms[i].Location.Line == ms[j].Location.Line && ms[i].Location.Column < ms[j].Location.Column)
 }
@@ -11,7 +11,9 @@ type operation struct {
 	Description  *string            `yaml:"description,omitempty"`
 	HeaderParams HeaderParams       `yaml:"header,omitempty"`
 	QueryParams  QueryParams        `yaml:"query,omitempty"`
+	CookieParams CookieParams       `yaml:"cookie,omitempty"`
 	Body         *RequestBody       `yaml:"body,omitempty"`
+	Security     Security           `yaml:"security,omitempty"`
 	Responses    OperationResponses `yaml:"response"`
 	Location     *yaml.Node
 }
@@ -23,7 +25,7 @@ func (operation *Operation) BodyIs(kind RequestBodyKind) bool {
 }
 
 func (operation *Operation) HasParams() bool {
-	return len(operation.QueryParams) > 0 || len(operation.HeaderParams) > 0 || len(operation.Endpoint.UrlParams) > 0
+	return len(operation.QueryParams) > 0 || len(operation.HeaderParams) > 0 || len(operation.CookieParams) > 0 || len(operation.Endpoint.UrlParams) > 0
 }
 
 func (value *Operation) UnmarshalYAML(node *yaml.Node) error {
@@ -54,9 +56,15 @@ func (value Operation) MarshalYAML() (interface{}, error) {
 	if len(value.QueryParams) > 0 {
 		yamlMap.Add("query", value.QueryParams)
 	}
+	if len(value.CookieParams) > 0 {
+		yamlMap.Add("cookie", value.CookieParams)
+	}
 	if !value.BodyIs(RequestBodyEmpty) {
 		yamlMap.Add("body", value.Body)
 	}
+	if value.Security != nil {
+		yamlMap.Add("security", value.Security)
+	}
 	yamlMap.Add("response", value.Responses)
 	return yamlMap.Node, nil
 }
@@ -71,6 +79,20 @@ func (op *NamedOperation) FullUrl() string {
 	return op.InApi.InHttp.GetUrl() + op.Endpoint.Url
 }
 
+func (op *NamedOperation) EffectiveSecurity() Security {
+	if op.Security != nil {
+		return op.Security
+	}
+	if op.InApi.Security != nil {
+		return op.InApi.Security
+	}
+	return op.InApi.InHttp.Security
+}
+
+func (op *NamedOperation) IsSecured() bool {
+	return len(op.EffectiveSecurity()) > 0
+}
+
 func (op *NamedOperation) FullName() string {
 	fullName := fmt.Sprintf(`%s.%s`, op.InApi.Name.Source, op.Name.Source)
 	if op.InApi.InHttp.InVersion.Name.Source != "" {
@@ -86,10 +108,13 @@ func (value *Operations) UnmarshalYAML(node *yaml.Node) error {
 		return yamlError(node, "operations should be YAML mapping")
 	}
 	count := len(node.Content) / 2
-	array := make([]NamedOperation, count)
+	array := []NamedOperation{}
 	for index := 0; index < count; index++ {
 		keyNode := node.Content[index*2]
 		valueNode := node.Content[index*2+1]
+		if contains([]string{"security"}, keyNode) {
+			continue
+		}
 		name := Name{}
 		err := keyNode.DecodeWith(decodeStrict, &name)
 		if err != nil {
@@ -104,7 +129,7 @@ func (value *Operations) UnmarshalYAML(node *yaml.Node) error {
 		if err != nil {
 			return err
 		}
-		array[index] = NamedOperation{Name: name, Operation: operation}
+		array = append(array, NamedOperation{Name: name, Operation: operation})
 	}
 	*value = array
 	return nil
@@ -101,12 +101,11 @@ func (value *OperationResponses) UnmarshalYAML(node *yaml.Node) error {
 		if _, ok := httpStatusCode[name.Source]; !ok {
 			return yamlError(keyNode, fmt.Sprintf("unknown response name %s", name.Source))
 		}
-		body := ResponseBody{}
-		err = valueNode.DecodeWith(decodeStrict, &body)
+		body, headers, description, err := decodeResponse(keyNode, valueNode)
 		if err != nil {
 			return err
 		}
-		response := Response{name, body, getDescriptionFromComment(valueNode)}
+		response := Response{name, *body, headers, description}
 		array[index] = OperationResponse{response, nil, nil}
 	}
 	*value = array
@@ -117,7 +116,7 @@ func (value OperationResponses) MarshalYAML() (interface{}, error) {
 	yamlMap := yamlx.Map()
 	for index := 0; index < len(value); index++ {
 		response := value[index]
-		err := yamlMap.AddWithComment(response.Name, response.Body, response.Description)
+		err := yamlMap.AddWithComment(response.Name, response.value(), response.Description)
 		if err != nil {
 			return nil, err
 		}
@@ -23,3 +23,30 @@ bad_request: empty # invalid request
 	var responses OperationResponses
 	checkUnmarshalMarshal(t, expectedYaml, &responses)
 }
+
+func Test_Responses_Headers_Marshal(t *testing.T) {
+	expectedYaml := strings.TrimLeft(`
+ok: empty # success
+created: # created
+  body: string
+  header:
+    Location: string
+    Retry-After: int?
+`, "\n")
+	var responses OperationResponses
+	checkUnmarshalMarshal(t, expectedYaml, &responses)
+}
+
+func Test_Responses_Headers_Unmarshal(t *testing.T) {
+	data := `
+ok:
+  header:
+    ETag: string
+`
+	var responses OperationResponses
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &responses)
+	assert.NilError(t, err)
+	assert.Equal(t, responses[0].Body.IsEmpty(), true)
+	assert.Equal(t, responses[0].HasHeaders(), true)
+	assert.Equal(t, responses[0].Headers[0].Name.Source, "ETag")
+}
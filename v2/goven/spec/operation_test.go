@@ -70,6 +70,8 @@ header:
   Message: string?
 query:
   message: string?
+cookie:
+  session: string?
 body: Some # body description
 response:
   ok: empty
@@ -9,6 +9,7 @@ import (
 type UrlParams Params
 type QueryParams Params
 type HeaderParams Params
+type CookieParams Params
 
 type Params []NamedParam
 
@@ -62,6 +63,16 @@ func (value *HeaderParams) UnmarshalYAML(node *yaml.Node) error {
 
 }
 
+func (value *CookieParams) UnmarshalYAML(node *yaml.Node) error {
+	params := &Params{}
+	err := params.paramsUnmarshalYAML(node, "cookie")
+	if err != nil {
+		return err
+	}
+	*value = []NamedParam(*params)
+	return nil
+}
+
 func paramsMarshalYAML(params []NamedParam) (interface{}, error) {
 	yamlMap := yamlx.Map()
 	for index := 0; index < len(params); index++ {
@@ -81,3 +92,7 @@ func (params QueryParams) MarshalYAML() (interface{}, error) {
 func (params HeaderParams) MarshalYAML() (interface{}, error) {
 	return paramsMarshalYAML(params)
 }
+
+func (params CookieParams) MarshalYAML() (interface{}, error) {
+	return paramsMarshalYAML(params)
+}
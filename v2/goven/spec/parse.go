@@ -2,7 +2,9 @@ package spec
 
 import (
 	"bytes"
+	"errors"
 	"gopkg.in/specgen-io/yaml.v3"
+	"os"
 )
 
 type SpecParseResult struct {
@@ -21,6 +23,39 @@ type SpecOptions struct {
 var SpecOptionsDefault = SpecOptions{true}
 
 func ReadSpecWithOptions(options SpecOptions, data []byte) (*Spec, *Messages, error) {
+	return readSpec(options, data, newSpecFiles(""))
+}
+
+func ReadSpecFile(path string) (*Spec, *Messages, error) {
+	return ReadSpecFileWithOptions(SpecOptionsDefault, path)
+}
+
+func ReadSpecFileWithOptions(options SpecOptions, path string) (*Spec, *Messages, error) {
+	spec, _, messages, err := ReadSpecFileWithImports(options, path)
+	return spec, messages, err
+}
+
+// ReadSpecFileWithImports also returns paths of the specification file and all files it imports,
+// paths are returned even when reading fails so the caller can still watch them for changes.
+func ReadSpecFileWithImports(options SpecOptions, path string) (*Spec, []string, *Messages, error) {
+	files := newSpecFiles(path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		messages := NewMessages()
+		messages.Add(Error("failed to read specification file: %s", err.Error()))
+		return nil, files.paths(), messages, errors.New("failed to read specification")
+	}
+	spec, messages, err := readSpec(options, data, files)
+	return spec, files.paths(), messages, err
+}
+
+func readSpec(options SpecOptions, data []byte, files *specFiles) (*Spec, *Messages, error) {
+	spec, messages, err := readSpecFiles(options, data, files)
+	files.locate(messages)
+	return spec, messages, err
+}
+
+func readSpecFiles(options SpecOptions, data []byte, files *specFiles) (*Spec, *Messages, error) {
 	allMessages := NewMessages()
 	data, messages, err := checkSpecVersion(data)
 	allMessages.AddAll(messages.Items...)
@@ -28,7 +63,7 @@ func ReadSpecWithOptions(options SpecOptions, data []byte) (*Spec, *Messages, er
 		return nil, allMessages, err
 	}
 
-	spec, messages, err := unmarshalSpec(data)
+	spec, messages, err := unmarshalSpec(data, files)
 	allMessages.AddAll(messages.Items...)
 	if err != nil {
 		return nil, allMessages, err
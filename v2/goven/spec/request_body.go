@@ -107,6 +107,7 @@ const (
 	RequestBodyEmpty          RequestBodyKind = "empty"
 	RequestBodyString         RequestBodyKind = "string"
 	RequestBodyJson           RequestBodyKind = "json"
+	RequestBodyBinary         RequestBodyKind = "binary"
 	RequestBodyFormData       RequestBodyKind = "form-data"
 	RequestBodyFormUrlEncoded RequestBodyKind = "form-urlencoded"
 )
@@ -117,6 +118,8 @@ func (body *RequestBody) Kind() RequestBodyKind {
 			return RequestBodyEmpty
 		} else if body.Type.Definition.Plain == TypeString {
 			return RequestBodyString
+		} else if body.Type.Definition.IsFile() {
+			return RequestBodyBinary
 		} else {
 			return RequestBodyJson
 		}
@@ -146,6 +149,10 @@ func (body *RequestBody) IsJson() bool {
 	return body.Kind() == RequestBodyJson
 }
 
+func (body *RequestBody) IsBinary() bool {
+	return body.Kind() == RequestBodyBinary
+}
+
 func (body *RequestBody) IsBodyFormData() bool {
 	return body.Kind() == RequestBodyFormData
 }
@@ -1,13 +1,21 @@
 package spec
 
-import "strconv"
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+	"strconv"
+)
 
 type Response struct {
 	Name        Name
 	Body        ResponseBody
+	Headers     HeaderParams
 	Description *string
 }
 
+func (response *Response) HasHeaders() bool {
+	return len(response.Headers) > 0
+}
+
 func (response *Response) IsSuccess() bool {
 	statusCode, _ := strconv.Atoi(HttpStatusCode(response.Name))
 	return statusCode >= 200 && statusCode <= 399
@@ -17,3 +25,36 @@ func (response *Response) IsError() bool {
 	statusCode, _ := strconv.Atoi(HttpStatusCode(response.Name))
 	return statusCode >= 400 && statusCode <= 599
 }
+
+type responseWithHeaders struct {
+	Body    *ResponseBody `yaml:"body"`
+	Headers HeaderParams  `yaml:"header"`
+}
+
+func decodeResponse(keyNode *yaml.Node, valueNode *yaml.Node) (*ResponseBody, HeaderParams, *string, error) {
+	if valueNode.Kind == yaml.MappingNode {
+		internal := responseWithHeaders{}
+		err := valueNode.DecodeWith(decodeStrict, &internal)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		body := internal.Body
+		if body == nil {
+			body = &ResponseBody{Location: valueNode}
+		}
+		return body, internal.Headers, getDescriptionFromComment(keyNode), nil
+	}
+	body := ResponseBody{}
+	err := valueNode.DecodeWith(decodeStrict, &body)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return &body, nil, getDescriptionFromComment(valueNode), nil
+}
+
+func (response *Response) value() interface{} {
+	if response.HasHeaders() {
+		return responseWithHeaders{&response.Body, response.Headers}
+	}
+	return response.Body
+}
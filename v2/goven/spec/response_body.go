@@ -1,11 +1,14 @@
 package spec
 
 import (
+	"fmt"
 	"gopkg.in/specgen-io/yaml.v3"
+	"strings"
 )
 
 type ResponseBody struct {
 	Type     *Type
+	Stream   ResponseBodyKind
 	Location *yaml.Node
 }
 
@@ -15,14 +18,45 @@ const (
 	ResponseBodyEmpty  ResponseBodyKind = "empty"
 	ResponseBodyString ResponseBodyKind = "string"
 	ResponseBodyJson   ResponseBodyKind = "json"
+	ResponseBodyBinary ResponseBodyKind = "binary"
+	ResponseBodyNdjson ResponseBodyKind = "ndjson"
+	ResponseBodySse    ResponseBodyKind = "sse"
 )
 
+var streamWrappers = map[string]ResponseBodyKind{
+	"stream": ResponseBodyNdjson,
+	"sse":    ResponseBodySse,
+}
+
+func parseStreamWrapper(value string) (ResponseBodyKind, string) {
+	for wrapper, kind := range streamWrappers {
+		prefix := wrapper + "<"
+		if strings.HasPrefix(value, prefix) && strings.HasSuffix(value, ">") {
+			return kind, strings.TrimSuffix(strings.TrimPrefix(value, prefix), ">")
+		}
+	}
+	return "", value
+}
+
+func streamWrapperName(kind ResponseBodyKind) string {
+	for wrapper, wrapperKind := range streamWrappers {
+		if wrapperKind == kind {
+			return wrapper
+		}
+	}
+	return ""
+}
+
 func (body *ResponseBody) Kind() ResponseBodyKind {
 	if body != nil {
 		if body.Type == nil || body.Type.Definition.IsEmpty() {
 			return ResponseBodyEmpty
+		} else if body.Stream != "" {
+			return body.Stream
 		} else if body.Type.Definition.Plain == TypeString {
 			return ResponseBodyString
+		} else if body.Type.Definition.IsFile() {
+			return ResponseBodyBinary
 		} else {
 			return ResponseBodyJson
 		}
@@ -46,6 +80,14 @@ func (body *ResponseBody) IsJson() bool {
 	return body.Kind() == ResponseBodyJson
 }
 
+func (body *ResponseBody) IsBinary() bool {
+	return body.Kind() == ResponseBodyBinary
+}
+
+func (body *ResponseBody) IsStream() bool {
+	return body.Kind() == ResponseBodyNdjson || body.Kind() == ResponseBodySse
+}
+
 func (value *ResponseBody) UnmarshalYAML(node *yaml.Node) error {
 	if node.Kind != yaml.ScalarNode {
 		return yamlError(node, "definition has to be scalar value")
@@ -53,11 +95,12 @@ func (value *ResponseBody) UnmarshalYAML(node *yaml.Node) error {
 	if node.Value == "empty" {
 		*value = ResponseBody{Location: node}
 	} else {
-		typ, err := parseType(node.Value)
+		stream, typeName := parseStreamWrapper(node.Value)
+		typ, err := parseType(typeName)
 		if err != nil {
 			return yamlError(node, err.Error())
 		}
-		*value = ResponseBody{&Type{*typ, node}, node}
+		*value = ResponseBody{Type: &Type{*typ, node}, Stream: stream, Location: node}
 	}
 	return nil
 }
@@ -67,8 +110,7 @@ func (value ResponseBody) MarshalYAML() (interface{}, error) {
 		node := yaml.Node{Kind: yaml.ScalarNode, Value: "empty"}
 		return node, nil
 	} else {
-		yamlValue := value.Type.Definition.String()
-		node := yaml.Node{Kind: yaml.ScalarNode, Value: yamlValue}
+		node := yaml.Node{Kind: yaml.ScalarNode, Value: value.String()}
 		return node, nil
 	}
 }
@@ -76,6 +118,8 @@ func (value ResponseBody) MarshalYAML() (interface{}, error) {
 func (value *ResponseBody) String() string {
 	if value.IsEmpty() {
 		return "empty"
+	} else if value.IsStream() {
+		return fmt.Sprintf("%s<%s>", streamWrapperName(value.Stream), value.Type.Definition.String())
 	} else {
 		return value.Type.Definition.String()
 	}
@@ -51,3 +51,28 @@ func Test_ResponseBody_Empty_Marshal(t *testing.T) {
 	var definition Definition
 	checkUnmarshalMarshal(t, expectedYaml, &definition)
 }
+
+func Test_ResponseBody_Stream_Unmarshal(t *testing.T) {
+	data := "stream<MyType>"
+	var body ResponseBody
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &body)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, reflect.DeepEqual(body.Type.Definition, ParseType("MyType")), true)
+	assert.Equal(t, body.Kind(), ResponseBodyNdjson)
+	assert.Equal(t, body.String(), "stream<MyType>")
+}
+
+func Test_ResponseBody_Sse_Unmarshal(t *testing.T) {
+	data := "sse<MyType[]>"
+	var body ResponseBody
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &body)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, reflect.DeepEqual(body.Type.Definition, ParseType("MyType[]")), true)
+	assert.Equal(t, body.Kind(), ResponseBodySse)
+}
+
+func Test_ResponseBody_Stream_Marshal(t *testing.T) {
+	expectedYaml := "sse<MyType>\n"
+	var body ResponseBody
+	checkUnmarshalMarshal(t, expectedYaml, &body)
+}
@@ -1,19 +1,152 @@
 package spec
 
 import (
+	"github.com/specgen-io/specgen-golang/v2/goven/yamlx"
 	"gopkg.in/specgen-io/yaml.v3"
 )
 
-type securityRef struct {
+type SecuritySchemeType string
+
+const (
+	SecurityBearer SecuritySchemeType = "bearer"
+	SecurityBasic  SecuritySchemeType = "basic"
+	SecurityApiKey SecuritySchemeType = "api-key"
+)
+
+const (
+	ApiKeyInHeader string = "header"
+	ApiKeyInQuery  string = "query"
+)
+
+type SecurityScheme struct {
+	Type        SecuritySchemeType `yaml:"type"`
+	In          *string            `yaml:"in,omitempty"`
+	KeyName     *string            `yaml:"name,omitempty"`
+	Description *string            `yaml:"description,omitempty"`
+	Location    *yaml.Node
+}
+
+func (self *SecurityScheme) IsBearer() bool {
+	return self.Type == SecurityBearer
+}
+
+func (self *SecurityScheme) IsBasic() bool {
+	return self.Type == SecurityBasic
+}
+
+func (self *SecurityScheme) IsApiKey() bool {
+	return self.Type == SecurityApiKey
+}
+
+func (value *SecurityScheme) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*value = SecurityScheme{Type: SecuritySchemeType(node.Value), Description: getDescriptionFromComment(node), Location: node}
+		return nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return yamlError(node, "security scheme should be either scheme type or YAML mapping")
+	}
+	type securityScheme SecurityScheme
+	internal := securityScheme{}
+	err := node.DecodeWith(decodeStrict, &internal)
+	if err != nil {
+		return err
+	}
+	internal.Location = node
+	*value = SecurityScheme(internal)
+	return nil
+}
+
+func (value SecurityScheme) MarshalYAML() (interface{}, error) {
+	if value.In == nil && value.KeyName == nil && value.Description == nil {
+		return string(value.Type), nil
+	}
+	yamlMap := yamlx.Map()
+	yamlMap.Add("type", string(value.Type))
+	yamlMap.AddOmitNil("in", value.In)
+	yamlMap.AddOmitNil("name", value.KeyName)
+	yamlMap.AddOmitNil("description", value.Description)
+	return yamlMap.Node, nil
+}
+
+type NamedSecurityScheme struct {
 	Name Name
-	Args []string
+	SecurityScheme
+}
+
+type SecuritySchemes []NamedSecurityScheme
+
+func (schemes SecuritySchemes) Get(name string) *NamedSecurityScheme {
+	for index := range schemes {
+		if schemes[index].Name.Source == name {
+			return &schemes[index]
+		}
+	}
+	return nil
+}
+
+func (value *SecuritySchemes) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return yamlError(node, "security should be YAML mapping")
+	}
+	count := len(node.Content) / 2
+	array := make([]NamedSecurityScheme, count)
+	for index := 0; index < count; index++ {
+		keyNode := node.Content[index*2]
+		valueNode := node.Content[index*2+1]
+		name := Name{}
+		err := keyNode.DecodeWith(decodeStrict, &name)
+		if err != nil {
+			return err
+		}
+		err = name.Check(SnakeCase)
+		if err != nil {
+			return err
+		}
+		scheme := SecurityScheme{}
+		err = valueNode.DecodeWith(decodeStrict, &scheme)
+		if err != nil {
+			return err
+		}
+		array[index] = NamedSecurityScheme{Name: name, SecurityScheme: scheme}
+	}
+	*value = array
+	return nil
+}
+
+func (value SecuritySchemes) MarshalYAML() (interface{}, error) {
+	yamlMap := yamlx.Map()
+	for index := 0; index < len(value); index++ {
+		scheme := value[index]
+		err := yamlMap.Add(scheme.Name, scheme.SecurityScheme)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return yamlMap.Node, nil
+}
+
+type securityRef struct {
+	Name     Name
+	Args     []string
+	Scheme   *NamedSecurityScheme
+	Location *yaml.Node
 }
 
 type SecurityRef securityRef
 
 func (value *SecurityRef) UnmarshalYAML(node *yaml.Node) error {
-	if node.Kind != yaml.MappingNode {
-		return yamlError(node, "security reference should be YAML mapping")
+	if node.Kind == yaml.ScalarNode {
+		name := Name{}
+		err := node.DecodeWith(decodeStrict, &name)
+		if err != nil {
+			return err
+		}
+		*value = SecurityRef{Name: name, Args: []string{}, Location: node}
+		return nil
+	}
+	if node.Kind != yaml.MappingNode || len(node.Content) != 2 {
+		return yamlError(node, "security reference should be either scheme name or YAML mapping with single scheme name")
 	}
 	keyNode := node.Content[0]
 	valueNode := node.Content[1]
@@ -30,12 +163,50 @@ func (value *SecurityRef) UnmarshalYAML(node *yaml.Node) error {
 		return err
 	}
 
-	*value = SecurityRef{name, args}
+	*value = SecurityRef{Name: name, Args: args, Location: node}
 	return nil
 }
 
 func (value SecurityRef) MarshalYAML() (interface{}, error) {
-	return nil, nil
+	if len(value.Args) == 0 {
+		return value.Name.Source, nil
+	}
+	yamlMap := yamlx.Map()
+	yamlMap.Add(value.Name, value.Args)
+	return yamlMap.Node, nil
 }
 
 type Security []SecurityRef
+
+func (value *Security) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		return yamlError(node, "security should be YAML sequence")
+	}
+	array := Security{}
+	for _, itemNode := range node.Content {
+		ref := SecurityRef{}
+		err := itemNode.DecodeWith(decodeStrict, &ref)
+		if err != nil {
+			return err
+		}
+		array = append(array, ref)
+	}
+	*value = array
+	return nil
+}
+
+func (value Security) MarshalYAML() (interface{}, error) {
+	yamlArray := yamlx.Array()
+	for _, ref := range value {
+		yamlArray.Add(ref)
+	}
+	return yamlArray.Node, nil
+}
+
+func (value Security) SchemesNames() []string {
+	names := []string{}
+	for _, ref := range value {
+		names = append(names, ref.Name.Source)
+	}
+	return names
+}
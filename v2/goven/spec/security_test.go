@@ -0,0 +1,205 @@
+package spec
+
+import (
+	"errors"
+	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+func Test_SecuritySchemes_Unmarshal(t *testing.T) {
+	data := `
+bearer_auth: bearer
+api_key:
+  type: api-key
+  in: header
+  name: X-Api-Key
+`
+	var schemes SecuritySchemes
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &schemes)
+	assert.Equal(t, err, nil)
+
+	assert.Equal(t, len(schemes), 2)
+	bearer := schemes[0]
+	apiKey := schemes[1]
+	assert.Equal(t, bearer.Name.Source, "bearer_auth")
+	assert.Equal(t, bearer.IsBearer(), true)
+	assert.Equal(t, apiKey.Name.Source, "api_key")
+	assert.Equal(t, apiKey.IsApiKey(), true)
+	assert.Equal(t, *apiKey.In, "header")
+	assert.Equal(t, *apiKey.KeyName, "X-Api-Key")
+}
+
+func Test_SecuritySchemes_Marshal(t *testing.T) {
+	expectedYaml := strings.TrimLeft(`
+bearer_auth: bearer
+api_key:
+  type: api-key
+  in: query
+  name: api_key
+`, "\n")
+	var schemes SecuritySchemes
+	checkUnmarshalMarshal(t, expectedYaml, &schemes)
+}
+
+func Test_Security_Unmarshal(t *testing.T) {
+	data := `
+- bearer_auth
+- oauth: [read, write]
+`
+	var security Security
+	err := yaml.UnmarshalWith(decodeStrict, []byte(data), &security)
+	assert.Equal(t, err, nil)
+
+	assert.Equal(t, len(security), 2)
+	assert.Equal(t, security[0].Name.Source, "bearer_auth")
+	assert.DeepEqual(t, security[0].Args, []string{})
+	assert.Equal(t, security[1].Name.Source, "oauth")
+	assert.DeepEqual(t, security[1].Args, []string{"read", "write"})
+}
+
+func Test_Security_Marshal(t *testing.T) {
+	expectedYaml := strings.TrimLeft(`
+- bearer_auth
+- oauth:
+    - read
+    - write
+`, "\n")
+	var security Security
+	checkUnmarshalMarshal(t, expectedYaml, &security)
+}
+
+func Test_Apis_Security_Marshal(t *testing.T) {
+	expectedYaml := strings.TrimLeft(`
+security:
+  - bearer_auth
+test:
+  security:
+    - api_key
+  ping:
+    endpoint: GET /ping
+    security: []
+    response:
+      ok: empty
+`, "\n")
+	var apis Http
+	checkUnmarshalMarshal(t, expectedYaml, &apis)
+}
+
+func Test_Security(t *testing.T) {
+	runReadSpecificationCases(t, securityCases)
+}
+
+var securityCases = []ReadSpecificationCase{
+	{
+		`security inherited from http`,
+		`
+security:
+  bearer_auth: bearer
+http:
+  security:
+    - bearer_auth
+  test:
+    secured:
+      endpoint: GET /secured
+      response:
+        ok: empty
+    public:
+      endpoint: GET /public
+      security: []
+      response:
+        ok: empty
+`,
+		nil,
+		[]Message{},
+		func(t *testing.T, spec *Spec) {
+			operations := spec.Versions[0].Http.Apis[0].Operations
+			secured := operations[0]
+			public := operations[1]
+			assert.Equal(t, secured.IsSecured(), true)
+			assert.Equal(t, secured.EffectiveSecurity()[0].Scheme.Name.Source, "bearer_auth")
+			assert.Equal(t, public.IsSecured(), false)
+		},
+	},
+	{
+		`security inherited from api`,
+		`
+security:
+  bearer_auth: bearer
+  api_key:
+    type: api-key
+    in: query
+    name: api_key
+http:
+  security:
+    - bearer_auth
+  test:
+    security:
+      - api_key
+    secured:
+      endpoint: GET /secured
+      response:
+        ok: empty
+`,
+		nil,
+		[]Message{},
+		func(t *testing.T, spec *Spec) {
+			api := spec.Versions[0].Http.Apis[0]
+			assert.Equal(t, len(api.Operations), 1)
+			assert.Equal(t, api.Operations[0].EffectiveSecurity()[0].Scheme.Name.Source, "api_key")
+		},
+	},
+	{
+		`unknown security scheme`,
+		`
+security:
+  bearer_auth: bearer
+http:
+  test:
+    secured:
+      endpoint: GET /secured
+      security:
+        - basic_auth
+      response:
+        ok: empty
+`,
+		errors.New("failed to parse specification"),
+		[]Message{Error("unknown security scheme: basic_auth").At(&Location{specificationMetaLines + 8, 11})},
+		nil,
+	},
+	{
+		`api key scheme without name`,
+		`
+security:
+  api_key:
+    type: api-key
+    in: header
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("security scheme 'api_key' of type api-key should declare 'name'").At(&Location{specificationMetaLines + 3, 5})},
+		nil,
+	},
+	{
+		`bearer scheme with in`,
+		`
+security:
+  bearer_auth:
+    type: bearer
+    in: header
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("security scheme 'bearer_auth' of type bearer should not declare 'in'").At(&Location{specificationMetaLines + 3, 5})},
+		nil,
+	},
+	{
+		`unknown scheme type`,
+		`
+security:
+  digest_auth: digest
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("security scheme 'digest_auth' has unknown type: digest, should be one of: bearer, basic, api-key").At(&Location{specificationMetaLines + 2, 16})},
+		nil,
+	},
+}
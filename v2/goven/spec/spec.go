@@ -10,6 +10,8 @@ type Spec struct {
 	Meta
 	Versions   []Version
 	HttpErrors *HttpErrors
+	Security   SecuritySchemes
+	files      *specFiles
 }
 
 type VersionSpecification struct {
@@ -88,7 +90,16 @@ func (value *Spec) UnmarshalYAML(node *yaml.Node) error {
 		httpErrors = &httpErrorsValue
 	}
 
-	*value = Spec{meta, versions, httpErrors}
+	security := SecuritySchemes{}
+	securityNode := getMappingValue(node, "security")
+	if securityNode != nil {
+		err := securityNode.DecodeWith(decodeStrict, &security)
+		if err != nil {
+			return err
+		}
+	}
+
+	*value = Spec{meta, versions, httpErrors, security, nil}
 	return nil
 }
 
@@ -106,6 +117,9 @@ func (value VersionSpecification) MarshalYAML() (interface{}, error) {
 func (value Spec) MarshalYAML() (interface{}, error) {
 	yamlMap := yamlx.Map()
 	yamlMap.Merge(value.Meta)
+	if len(value.Security) > 0 {
+		yamlMap.Add("security", value.Security)
+	}
 	for index := 0; index < len(value.Versions); index++ {
 		version := value.Versions[index]
 
@@ -115,6 +129,9 @@ func (value Spec) MarshalYAML() (interface{}, error) {
 			yamlMap.Merge(version.VersionSpecification)
 		}
 	}
+	if httpErrors := value.HttpErrors.declared(); httpErrors != nil {
+		yamlMap.Add("errors", httpErrors)
+	}
 	return yamlMap.Node, nil
 }
 
@@ -128,13 +145,29 @@ func (value Meta) MarshalYAML() (interface{}, error) {
 	return yamlMap.Node, nil
 }
 
-func unmarshalSpec(data []byte) (*Spec, *Messages, error) {
+func unmarshalSpec(data []byte, files *specFiles) (*Spec, *Messages, error) {
 	messages := NewMessages()
+	node, err := parseSpecNode(data)
+	if err != nil {
+		messages.Add(convertYamlError(err, nil))
+		return nil, messages, errors.New("failed to read specification")
+	}
+	files.register(node, files.mainFile)
+	messages.AddAll(files.resolveImports(node, files.baseDir).Items...)
+	if messages.ContainsLevel(LevelError) {
+		return nil, messages, errors.New("failed to read specification")
+	}
 	var spec Spec
-	err := yaml.UnmarshalWith(decodeStrict, data, &spec)
+	err = node.DecodeWith(decodeStrict, &spec)
 	if err != nil {
 		messages.Add(convertYamlError(err, nil))
 		return nil, messages, errors.New("failed to read specification")
 	}
+	spec.files = files
 	return &spec, messages, nil
 }
+
+// Locate returns the location of the node and the file the node was read from.
+func (spec *Spec) Locate(node *yaml.Node) (*Location, string) {
+	return locationFromNode(node), spec.files.file(node)
+}
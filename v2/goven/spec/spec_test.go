@@ -261,3 +261,30 @@ http:
 	var spec Spec
 	checkUnmarshalMarshal(t, expectedYaml, &spec)
 }
+
+func Test_Spec_Write_Errors(t *testing.T) {
+	expectedYaml := strings.TrimLeft(`
+spec: 2.1
+name: bla-api
+version: 3
+http:
+  test:
+    ping:
+      endpoint: GET /ping
+      response:
+        ok: empty
+        conflict: Conflict
+errors:
+  responses:
+    conflict: Conflict
+  models:
+    Conflict:
+      object:
+        message: string
+`, "\n")
+	spec, _, err := ReadSpec([]byte(expectedYaml))
+	assert.NilError(t, err)
+	data, err := WriteSpec(spec)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), expectedYaml)
+}
@@ -44,6 +44,17 @@ func (self *TypeDef) IsEmpty() bool {
 	return self.Node == PlainType && self.Plain == TypeEmpty
 }
 
+func (self *TypeDef) IsFile() bool {
+	return self.Node == PlainType && self.Plain == TypeFile
+}
+
+func (self *TypeDef) HasFile() bool {
+	if self.Node == PlainType {
+		return self.Plain == TypeFile
+	}
+	return self.Child.HasFile()
+}
+
 func (self *TypeDef) IsNullable() bool {
 	return self.Node == NullableType
 }
@@ -140,21 +151,24 @@ const (
 	TypeDate     string = "date"
 	TypeDateTime string = "datetime"
 	TypeJson     string = "json"
+	TypeFile     string = "file"
 	TypeEmpty    string = "empty"
 )
 
 const (
-	TypeAliasInt  string = "int"
-	TypeAliasLong string = "long"
-	TypeAliasBool string = "bool"
-	TypeAliasStr  string = "str"
+	TypeAliasInt    string = "int"
+	TypeAliasLong   string = "long"
+	TypeAliasBool   string = "bool"
+	TypeAliasStr    string = "str"
+	TypeAliasBinary string = "binary"
 )
 
 var TypesAliases = map[string]string{
-	TypeAliasInt:  TypeInt32,
-	TypeAliasLong: TypeInt64,
-	TypeAliasBool: TypeBoolean,
-	TypeAliasStr:  TypeString,
+	TypeAliasInt:    TypeInt32,
+	TypeAliasLong:   TypeInt64,
+	TypeAliasBool:   TypeBoolean,
+	TypeAliasStr:    TypeString,
+	TypeAliasBinary: TypeFile,
 }
 
 func mapTypeAlias(value string) string {
@@ -171,6 +185,7 @@ const (
 	StructureScalar TypeStructure = 1
 	StructureArray  TypeStructure = 2
 	StructureObject TypeStructure = 3
+	StructureBinary TypeStructure = 4
 )
 
 type TypeInfo struct {
@@ -191,6 +206,7 @@ var Types = map[string]TypeInfo{
 	TypeDate:     {StructureScalar, true, nil},
 	TypeDateTime: {StructureScalar, true, nil},
 	TypeJson:     {StructureObject, false, nil},
+	TypeFile:     {StructureBinary, false, nil},
 	TypeEmpty:    {StructureNone, false, nil},
 }
 
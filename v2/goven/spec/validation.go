@@ -4,10 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"gopkg.in/specgen-io/yaml.v3"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
 func (self Message) String() string {
+	if self.Location != nil && self.File != "" {
+		return fmt.Sprintf(`%s - at %s (%d, %d): %s`, self.Level, self.File, self.Location.Line, self.Location.Column, self.Message)
+	}
 	if self.Location != nil {
 		return fmt.Sprintf(`%s - at (%d, %d): %s`, self.Level, self.Location.Line, self.Location.Column, self.Message)
 	}
@@ -16,7 +21,7 @@ func (self Message) String() string {
 
 func validate(spec *Spec) (*Messages, error) {
 	messages := NewMessages()
-	validator := &validator{messages}
+	validator := &validator{messages, spec.files}
 	validator.Spec(spec)
 	var err error = nil
 	if validator.Messages.ContainsLevel(LevelError) {
@@ -27,17 +32,21 @@ func validate(spec *Spec) (*Messages, error) {
 
 type validator struct {
 	Messages *Messages
+	files    *specFiles
 }
 
 func (validator *validator) addError(node *yaml.Node, message string) {
-	validator.Messages.Add(Error(message).At(locationFromNode(node)))
+	validator.Messages.Add(validator.files.at(Error(message), node))
 }
 
 func (validator *validator) addWarning(node *yaml.Node, message string) {
-	validator.Messages.Add(Warning(message).At(locationFromNode(node)))
+	validator.Messages.Add(validator.files.at(Warning(message), node))
 }
 
 func (validator *validator) Spec(spec *Spec) {
+	for index := range spec.Security {
+		validator.SecurityScheme(&spec.Security[index])
+	}
 	urlsMap := map[string][]*NamedOperation{}
 	for versionIndex := range spec.Versions {
 		models := spec.Versions[versionIndex].Models
@@ -68,6 +77,29 @@ func (validator *validator) Spec(spec *Spec) {
 	}
 }
 
+func (validator *validator) SecurityScheme(scheme *NamedSecurityScheme) {
+	switch scheme.Type {
+	case SecurityBearer, SecurityBasic:
+		if scheme.In != nil {
+			validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' of type %s should not declare 'in'`, scheme.Name.Source, scheme.Type))
+		}
+		if scheme.KeyName != nil {
+			validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' of type %s should not declare 'name'`, scheme.Name.Source, scheme.Type))
+		}
+	case SecurityApiKey:
+		if scheme.In == nil {
+			validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' of type %s should declare 'in'`, scheme.Name.Source, scheme.Type))
+		} else if *scheme.In != ApiKeyInHeader && *scheme.In != ApiKeyInQuery {
+			validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' declares unsupported 'in': %s, should be either %s or %s`, scheme.Name.Source, *scheme.In, ApiKeyInHeader, ApiKeyInQuery))
+		}
+		if scheme.KeyName == nil {
+			validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' of type %s should declare 'name'`, scheme.Name.Source, scheme.Type))
+		}
+	default:
+		validator.addError(scheme.Location, fmt.Sprintf(`security scheme '%s' has unknown type: %s, should be one of: %s, %s, %s`, scheme.Name.Source, scheme.Type, SecurityBearer, SecurityBasic, SecurityApiKey))
+	}
+}
+
 func (validator *validator) ParamsNames(paramsMap map[string]NamedParam, params []NamedParam) {
 	for _, p := range params {
 		if other, ok := paramsMap[p.Name.SnakeCase()]; ok {
@@ -84,24 +116,41 @@ func (validator *validator) Operation(operation *NamedOperation) {
 	validator.ParamsNames(paramsMap, operation.Endpoint.UrlParams)
 	validator.ParamsNames(paramsMap, operation.QueryParams)
 	validator.ParamsNames(paramsMap, operation.HeaderParams)
+	validator.ParamsNames(paramsMap, operation.CookieParams)
 
 	validator.Params(operation.Endpoint.UrlParams, false)
 	validator.Params(operation.QueryParams, true)
 	validator.Params(operation.HeaderParams, true)
+	validator.Params(operation.CookieParams, false)
 
-	if !operation.BodyIs(RequestBodyEmpty) && operation.Body.Type != nil {
+	if !operation.BodyIs(RequestBodyEmpty) && !operation.BodyIs(RequestBodyBinary) && operation.Body.Type != nil {
 		bodyType := operation.Body.Type
-		if bodyType.Definition.Info.Structure != StructureObject &&
+		if bodyType.Definition.HasFile() {
+			validator.addError(operation.Body.Location, fileUsageMessage(&bodyType.Definition))
+		} else if bodyType.Definition.Info.Structure != StructureObject &&
 			bodyType.Definition.Info.Structure != StructureArray &&
 			bodyType.Definition.String() != TypeString {
 			message := fmt.Sprintf("body should be object, array or string type, found %s", bodyType.Definition.Name)
 			validator.addError(operation.Body.Location, message)
 		}
 	}
+	if !operation.BodyIs(RequestBodyEmpty) && !operation.Endpoint.AllowsRequestBody() {
+		message := fmt.Sprintf("%s operation should not have body", operation.Endpoint.Method)
+		validator.addError(operation.Body.Location, message)
+	}
 	if operation.Body != nil {
 		validator.RequestBody(operation.Body)
 	}
 
+	if !operation.Endpoint.AllowsResponseBody() {
+		for _, response := range operation.Responses {
+			if !response.IsError() && !response.Body.IsEmpty() {
+				message := fmt.Sprintf("%s operation response %s should be empty", operation.Endpoint.Method, response.Name.Source)
+				validator.addError(response.Body.Location, message)
+			}
+		}
+	}
+
 	for index := range operation.Responses {
 		validator.OperationResponse(&operation.Responses[index])
 	}
@@ -121,13 +170,37 @@ func (validator *validator) OperationResponse(response *OperationResponse) {
 			}
 		}
 	}
-	if response.Body.IsJson() &&
+	if response.Body.IsBinary() && response.IsError() {
+		validator.addError(response.Body.Location, fmt.Sprintf(`response %s is an error response, binary body is supported only in success responses`, response.Name.Source))
+	}
+	if response.Body.IsStream() {
+		if response.IsError() {
+			validator.addError(response.Body.Location, fmt.Sprintf(`response %s is an error response, stream body is supported only in success responses`, response.Name.Source))
+		}
+		itemType := &response.Body.Type.Definition
+		if itemType.HasFile() {
+			validator.addError(response.Body.Type.Location, fileUsageMessage(itemType))
+		} else if itemType.Info.Structure != StructureObject && itemType.Info.Structure != StructureArray {
+			message := fmt.Sprintf("response %s stream items should be some type with structure of an object or array, found %s", response.Name.Source, itemType.Name)
+			validator.addError(response.Body.Type.Location, message)
+		}
+	}
+	if response.Body.IsJson() && response.Body.Type.Definition.HasFile() {
+		validator.addError(response.Body.Type.Location, fileUsageMessage(&response.Body.Type.Definition))
+	} else if response.Body.IsJson() &&
 		response.Body.Type.Definition.Info.Structure != StructureObject &&
 		response.Body.Type.Definition.Info.Structure != StructureArray {
 		message := fmt.Sprintf("response %s should be either empty or some type with structure of an object or array, found %s", response.Name.Source, response.Body.Type.Definition.Name)
 		validator.addError(response.Body.Type.Location, message)
 	}
 	validator.ResponseBody(&response.Body)
+	if response.HasHeaders() {
+		if response.IsError() {
+			validator.addError(response.Name.Location, fmt.Sprintf(`response %s is an error response, headers are supported only in success responses`, response.Name.Source))
+		}
+		validator.ParamsNames(make(map[string]NamedParam), response.Headers)
+		validator.Params(response.Headers, true)
+	}
 }
 
 func (validator *validator) Params(params []NamedParam, allowArrayTypes bool) {
@@ -136,7 +209,9 @@ func (validator *validator) Params(params []NamedParam, allowArrayTypes bool) {
 		paramType := params[index].DefinitionDefault.Type
 		scalar := paramType.Definition.Info.Structure == StructureScalar
 		arrayNotNullable := paramType.Definition.Info.Structure == StructureArray && !paramType.Definition.IsNullable()
-		if allowArrayTypes {
+		if paramType.Definition.HasFile() {
+			validator.addError(paramType.Location, fileUsageMessage(&paramType.Definition))
+		} else if allowArrayTypes {
 			if !scalar && !arrayNotNullable {
 				validator.addError(paramType.Location, fmt.Sprintf("parameter %s should be of scalar type or array of scalar type, found %s", paramName.Source, paramType.Definition.Name))
 			}
@@ -193,12 +268,23 @@ func (validator *validator) NonEmpty(definition *NamedDefinition) {
 	}
 }
 
+func (validator *validator) NonFile(definition *NamedDefinition) {
+	if definition.Type.Definition.HasFile() {
+		validator.addError(definition.Location, "type file can not be used in models")
+	}
+}
+
+func fileUsageMessage(typ *TypeDef) string {
+	return fmt.Sprintf("type %s is not allowed here, file can be used only as request body, response body or form-data parameter", typ.Name)
+}
+
 func (validator *validator) Model(model *NamedModel) {
 	if model.IsObject() {
 		validator.ItemsUniqueness(model.Location, model.Object.Fields, fmt.Sprintf(`object model %s fields names are too similiar to each other`, model.Name.Source))
 		for index := range model.Object.Fields {
 			field := model.Object.Fields[index]
 			validator.NonEmpty(&field)
+			validator.NonFile(&field)
 			validator.Definition(&field.Definition)
 		}
 	}
@@ -207,6 +293,7 @@ func (validator *validator) Model(model *NamedModel) {
 		for index := range model.OneOf.Items {
 			item := model.OneOf.Items[index]
 			validator.NonEmpty(&item)
+			validator.NonFile(&item)
 			validator.Definition(&item.Definition)
 		}
 	}
@@ -223,6 +310,7 @@ func (validator *validator) DefinitionDefault(definition *DefinitionDefault) {
 		if definition.Default != nil {
 			validator.DefaultValue(definition.Type.Definition, *definition.Default, definition.Location)
 		}
+		validator.Constraints(&definition.Type.Definition, definition.Constraints, definition.Location)
 	}
 }
 
@@ -292,9 +380,72 @@ func enumContainsItem(enum *Enum, what string) bool {
 }
 
 func (validator *validator) Definition(definition *Definition) {
+	if definition != nil {
+		validator.Constraints(&definition.Type.Definition, definition.Constraints, definition.Location)
+	}
+}
+
+func (validator *validator) Constraints(typ *TypeDef, constraints Constraints, location *yaml.Node) {
+	if len(constraints) == 0 {
+		return
+	}
+	baseType := typ.BaseType()
+	isString := baseType.Node == PlainType && baseType.Plain == TypeString
+	isInteger := baseType.Node == PlainType && (baseType.Plain == TypeInt32 || baseType.Plain == TypeInt64)
+	isNumber := baseType.Node == PlainType && (baseType.Plain == TypeFloat || baseType.Plain == TypeDouble || baseType.Plain == TypeDecimal)
+	isArray := baseType.Node == ArrayType
+	if !isString && !isInteger && !isNumber && !isArray {
+		validator.addError(location, fmt.Sprintf("type %s does not support constraints", typ.Name))
+		return
+	}
+	for _, constraint := range constraints {
+		switch constraint.Name {
+		case ConstraintMin, ConstraintMax:
+			format := Integer
+			if isNumber {
+				format = Float
+			}
+			err := format.Check(constraint.Value)
+			if err != nil {
+				validator.addError(location, fmt.Sprintf("constraint %s %s", constraint.Name, err.Error()))
+			} else if (isString || isArray) && strings.HasPrefix(constraint.Value, "-") {
+				validator.addError(location, fmt.Sprintf("constraint %s should not be negative for type %s, found %s", constraint.Name, typ.Name, constraint.Value))
+			}
+		case ConstraintPattern:
+			if !isString {
+				validator.addError(location, fmt.Sprintf("constraint %s is supported only for string type, found %s", constraint.Name, typ.Name))
+			} else if _, err := regexp.Compile(constraint.Value); err != nil {
+				validator.addError(location, fmt.Sprintf("constraint %s is not valid regular expression: %s", constraint.Name, err.Error()))
+			}
+		}
+	}
+	min, max := constraints.Min(), constraints.Max()
+	if min != nil && max != nil {
+		minValue, minErr := strconv.ParseFloat(*min, 64)
+		maxValue, maxErr := strconv.ParseFloat(*max, 64)
+		if minErr == nil && maxErr == nil && minValue > maxValue {
+			validator.addError(location, fmt.Sprintf("constraint min %s is greater than constraint max %s", *min, *max))
+		}
+	}
 }
 
 func (validator *validator) RequestBody(body *RequestBody) {
+	if body != nil {
+		for index := range body.FormData {
+			paramType := &body.FormData[index].Type
+			if paramType.Definition.HasFile() && !paramType.Definition.BaseType().IsFile() {
+				validator.addError(paramType.Location, fmt.Sprintf("form-data parameter %s should be of type file or file?, found %s", body.FormData[index].Name.Source, paramType.Definition.Name))
+			}
+			validator.DefinitionDefault(&body.FormData[index].DefinitionDefault)
+		}
+		for index := range body.FormUrlEncoded {
+			paramType := &body.FormUrlEncoded[index].Type
+			if paramType.Definition.HasFile() {
+				validator.addError(paramType.Location, fileUsageMessage(&paramType.Definition))
+			}
+			validator.DefinitionDefault(&body.FormUrlEncoded[index].DefinitionDefault)
+		}
+	}
 }
 
 func (validator *validator) ResponseBody(body *ResponseBody) {
@@ -2,6 +2,7 @@ package spec
 
 import (
 	"errors"
+	"gotest.tools/assert"
 	"testing"
 )
 
@@ -275,4 +276,263 @@ http:
 		[]Message{Warning(`endpoint "GET /some/url" is used for 2 operations: test.first, test.second`).At(&Location{specificationMetaLines + 4, 7})},
 		nil,
 	},
+	{
+		`patch request body no errors`,
+		`
+http:
+  test:
+    update:
+      endpoint: PATCH /some/url
+      body: string
+      response:
+        ok: empty
+`,
+		nil,
+		[]Message{},
+		nil,
+	},
+	{
+		`head request body error`,
+		`
+http:
+  test:
+    check:
+      endpoint: HEAD /some/url
+      body: string
+      response:
+        ok: empty
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("HEAD operation should not have body").At(&Location{specificationMetaLines + 5, 13})},
+		nil,
+	},
+	{
+		`head response body error`,
+		`
+http:
+  test:
+    check:
+      endpoint: HEAD /some/url
+      response:
+        ok: string
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("HEAD operation response ok should be empty").At(&Location{specificationMetaLines + 6, 13})},
+		nil,
+	},
+	{
+		`options request body error`,
+		`
+http:
+  test:
+    check:
+      endpoint: OPTIONS /some/url
+      body: string
+      response:
+        ok: empty
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("OPTIONS operation should not have body").At(&Location{specificationMetaLines + 5, 13})},
+		nil,
+	}, {
+		`response headers no errors`,
+		`
+http:
+  test:
+    create:
+      endpoint: POST /some/url
+      response:
+        created:
+          body: string
+          header:
+            Location: string
+            Retry-After: int?
+            Set-Cookie: string[]
+`,
+		nil,
+		[]Message{},
+		func(t *testing.T, spec *Spec) {
+			response := spec.Versions[0].Http.Apis[0].Operations[0].Responses[0]
+			assert.Equal(t, response.Body.String(), "string")
+			assert.Equal(t, len(response.Headers), 3)
+			assert.Equal(t, response.Headers[1].Type.Definition.Info.Structure, StructureScalar)
+		},
+	},
+	{
+		`error response headers error`,
+		`
+http:
+  test:
+    create:
+      endpoint: POST /some/url
+      response:
+        ok: empty
+        bad_request:
+          body: BadRequestError
+          header:
+            Retry-After: int
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("response bad_request is an error response, headers are supported only in success responses").At(&Location{specificationMetaLines + 7, 9})},
+		nil,
+	},
+	{
+		`response header object type error`,
+		`
+http:
+  test:
+    create:
+      endpoint: POST /some/url
+      response:
+        ok:
+          header:
+            X-Data: Data
+models:
+  Data:
+    object:
+      field: string
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("parameter X-Data should be of scalar type or array of scalar type, found Data").At(&Location{specificationMetaLines + 8, 21})},
+		nil,
+	},
+	{
+		`cookie params no errors`,
+		`
+http:
+  test:
+    some_url:
+      endpoint: GET /some/url
+      cookie:
+        session: string
+        page: int = 1
+      response:
+        ok: empty
+`,
+		nil,
+		[]Message{},
+		nil,
+	},
+	{
+		`cookie array param error`,
+		`
+http:
+  test:
+    some_url:
+      endpoint: GET /some/url
+      cookie:
+        ids: int[]
+      response:
+        ok: empty
+`,
+		errors.New("failed to validate specification"),
+		[]Message{Error("parameter ids should be of scalar type, found int[]").At(&Location{specificationMetaLines + 6, 14})},
+		nil,
+	},
+	{
+		`file body and form-data no errors`,
+		`
+http:
+  test:
+    upload:
+      endpoint: POST /upload
+      body: file
+      response:
+        ok: file
+    upload_form:
+      endpoint: POST /upload_form
+      body:
+        form-data:
+          document: file
+          thumbnail: file?
+          title: string
+      response:
+        ok: empty
+`,
+		nil,
+		[]Message{},
+		func(t *testing.T, spec *Spec) {
+			operations := spec.Versions[0].Http.Apis[0].Operations
+			assert.Equal(t, operations[0].Body.Kind(), RequestBodyBinary)
+			assert.Equal(t, operations[0].Responses[0].Body.Kind(), ResponseBodyBinary)
+		},
+	},
+	{
+		`file misuse errors`,
+		`
+http:
+  test:
+    upload:
+      endpoint: POST /upload
+      query:
+        document: file
+      body:
+        form-data:
+          documents: file[]
+      response:
+        ok: empty
+        conflict: file
+`,
+		errors.New("failed to validate specification"),
+		[]Message{
+			Error("type file is not allowed here, file can be used only as request body, response body or form-data parameter").At(&Location{specificationMetaLines + 6, 19}),
+			Error("form-data parameter documents should be of type file or file?, found file[]").At(&Location{specificationMetaLines + 9, 22}),
+			Error("response conflict is declared in the operation but it's not declared in errors section").At(&Location{specificationMetaLines + 12, 9}),
+			Error("response conflict is an error response, binary body is supported only in success responses").At(&Location{specificationMetaLines + 12, 19}),
+		},
+		nil,
+	},
+	{
+		`stream responses no errors`,
+		`
+http:
+  test:
+    export:
+      endpoint: GET /export
+      response:
+        ok: stream<Item>
+    notifications:
+      endpoint: GET /notifications
+      response:
+        ok:
+          body: sse<Item>
+          header:
+            X-Subscription: string
+models:
+  Item:
+    object:
+      field: string
+`,
+		nil,
+		[]Message{},
+		func(t *testing.T, spec *Spec) {
+			operations := spec.Versions[0].Http.Apis[0].Operations
+			assert.Equal(t, operations[0].Responses[0].Body.Kind(), ResponseBodyNdjson)
+			assert.Equal(t, operations[1].Responses[0].Body.Kind(), ResponseBodySse)
+			assert.Equal(t, operations[0].Responses[0].Body.Type.Definition.Info.Model.Name.Source, "Item")
+		},
+	},
+	{
+		`stream responses errors`,
+		`
+http:
+  test:
+    export:
+      endpoint: GET /export
+      response:
+        ok: stream<string>
+        conflict: sse<Item>
+models:
+  Item:
+    object:
+      field: string
+`,
+		errors.New("failed to validate specification"),
+		[]Message{
+			Error("response ok stream items should be some type with structure of an object or array, found string").At(&Location{specificationMetaLines + 6, 13}),
+			Error("response conflict is declared in the operation but it's not declared in errors section").At(&Location{specificationMetaLines + 7, 9}),
+			Error("response conflict is an error response, stream body is supported only in success responses").At(&Location{specificationMetaLines + 7, 19}),
+		},
+		nil,
+	},
 }
@@ -35,6 +35,18 @@ models:
 		[]Message{Error(`type empty can not be used in models`).At(&Location{specificationMetaLines + 4, 18})},
 		nil,
 	},
+	{
+		`object field is file error`,
+		`
+models:
+  MyObject:
+    object:
+      the_field: file[]
+`,
+		errors.New(`failed to validate specification`),
+		[]Message{Error(`type file can not be used in models`).At(&Location{specificationMetaLines + 4, 18})},
+		nil,
+	},
 	{
 		`oneOf items aren't unique error`,
 		`
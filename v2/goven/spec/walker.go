@@ -139,6 +139,7 @@ func (w *SpecWalker) Response(response *Response) {
 		w.onResponse(response)
 	}
 	w.ResponseBody(&response.Body)
+	w.params(response.Headers)
 }
 
 func (w *SpecWalker) Version(version *Version) {
@@ -170,6 +171,7 @@ func (w *SpecWalker) Operation(operation *NamedOperation) {
 	w.params(operation.Endpoint.UrlParams)
 	w.params(operation.QueryParams)
 	w.params(operation.HeaderParams)
+	w.params(operation.CookieParams)
 
 	if operation.Body != nil {
 		w.RequestBody(operation.Body)
@@ -254,9 +256,7 @@ func (w *SpecWalker) TypeDef(typ *TypeDef) {
 		switch typ.Node {
 		case PlainType:
 			return
-		case NullableType:
-		case ArrayType:
-		case MapType:
+		case NullableType, ArrayType, MapType:
 			w.TypeDef(typ.Child)
 		default:
 			panic(fmt.Sprintf("unknown kind of type: %v", typ))
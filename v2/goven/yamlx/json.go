@@ -0,0 +1,109 @@
+package yamlx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/specgen-io/yaml.v3"
+)
+
+func ToJsonString(data interface{}) (string, error) {
+	node := yaml.Node{}
+	err := node.Encode(data)
+	if err != nil {
+		return "", err
+	}
+	writer := new(bytes.Buffer)
+	err = writeJson(writer, &node, "")
+	if err != nil {
+		return "", err
+	}
+	writer.WriteString("\n")
+	return writer.String(), nil
+}
+
+func writeJson(writer *bytes.Buffer, node *yaml.Node, indent string) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			writer.WriteString("null")
+			return nil
+		}
+		return writeJson(writer, node.Content[0], indent)
+	case yaml.AliasNode:
+		return writeJson(writer, node.Alias, indent)
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			writer.WriteString("{}")
+			return nil
+		}
+		writer.WriteString("{\n")
+		for index := 0; index < len(node.Content); index += 2 {
+			if index > 0 {
+				writer.WriteString(",\n")
+			}
+			writer.WriteString(indent + "  ")
+			err := writeJsonValue(writer, node.Content[index].Value)
+			if err != nil {
+				return err
+			}
+			writer.WriteString(": ")
+			err = writeJson(writer, node.Content[index+1], indent+"  ")
+			if err != nil {
+				return err
+			}
+		}
+		writer.WriteString("\n" + indent + "}")
+		return nil
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			writer.WriteString("[]")
+			return nil
+		}
+		writer.WriteString("[\n")
+		for index, item := range node.Content {
+			if index > 0 {
+				writer.WriteString(",\n")
+			}
+			writer.WriteString(indent + "  ")
+			err := writeJson(writer, item, indent+"  ")
+			if err != nil {
+				return err
+			}
+		}
+		writer.WriteString("\n" + indent + "]")
+		return nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			writer.WriteString("null")
+		case "!!bool", "!!int", "!!float":
+			var value interface{}
+			err := node.Decode(&value)
+			if err != nil {
+				return err
+			}
+			err = writeJsonValue(writer, value)
+			if err != nil {
+				return err
+			}
+		default:
+			return writeJsonValue(writer, node.Value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported yaml node kind: %v", node.Kind)
+	}
+}
+
+func writeJsonValue(writer *bytes.Buffer, value interface{}) error {
+	data := new(bytes.Buffer)
+	encoder := json.NewEncoder(data)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(value)
+	if err != nil {
+		return err
+	}
+	writer.Write(bytes.TrimRight(data.Bytes(), "\n"))
+	return nil
+}
@@ -0,0 +1,33 @@
+package yamlx
+
+import (
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+func TestToJsonString(t *testing.T) {
+	theMap := Map()
+	theMap.Add("string", "the <string>")
+	theMap.Add("number", 3)
+	theMap.Add("flag", true)
+	theMap.AddRaw("raw", "0.5")
+	theMap.Add("array", Array("one", "two"))
+	theMap.Add("empty", Map())
+	json, err := ToJsonString(theMap)
+	assert.NilError(t, err)
+	expectedJson := `
+{
+  "string": "the <string>",
+  "number": 3,
+  "flag": true,
+  "raw": 0.5,
+  "array": [
+    "one",
+    "two"
+  ],
+  "empty": {}
+}
+`
+	assert.Equal(t, strings.TrimLeft(expectedJson, "\n"), json)
+}
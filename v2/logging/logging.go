@@ -0,0 +1,164 @@
+package logging
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/module"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+var Logrus = "logrus"
+var Slog = "slog"
+var Zap = "zap"
+var None = "none"
+
+var Values = []string{Logrus, Slog, Zap, None}
+
+func GenerateLogging(loggingModule module.Module, logging string) (*generator.CodeFile, error) {
+	w := writer.New(loggingModule, `logging.go`)
+	switch logging {
+	case Logrus:
+		w.Imports.AddAliased("github.com/sirupsen/logrus", "log")
+	case Zap:
+		w.Imports.Add("go.uber.org/zap")
+	case Slog, None:
+	default:
+		panic(fmt.Sprintf(`Unknown logging: %s`, logging))
+	}
+	w.Lines(`
+type Fields map[string]interface{}
+
+func (fields Fields) With(key string, value interface{}) Fields {
+	result := Fields{}
+	for k, v := range fields {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+type Logger interface {
+	Debug(fields Fields, message string)
+	Info(fields Fields, message string)
+	Warn(fields Fields, message string)
+	Error(fields Fields, message string)
+}
+
+var logger Logger = defaultLogger{}
+
+func SetLogger(l Logger) {
+	logger = l
+}
+
+func Debug(fields Fields, message string) {
+	logger.Debug(fields, message)
+}
+
+func Info(fields Fields, message string) {
+	logger.Info(fields, message)
+}
+
+func Warn(fields Fields, message string) {
+	logger.Warn(fields, message)
+}
+
+func Error(fields Fields, message string) {
+	logger.Error(fields, message)
+}
+
+type defaultLogger struct{}
+`)
+	switch logging {
+	case Logrus:
+		w.Lines(`
+func (defaultLogger) Debug(fields Fields, message string) {
+	log.WithFields(log.Fields(fields)).Debug(message)
+}
+
+func (defaultLogger) Info(fields Fields, message string) {
+	log.WithFields(log.Fields(fields)).Info(message)
+}
+
+func (defaultLogger) Warn(fields Fields, message string) {
+	log.WithFields(log.Fields(fields)).Warn(message)
+}
+
+func (defaultLogger) Error(fields Fields, message string) {
+	log.WithFields(log.Fields(fields)).Error(message)
+}
+`)
+	case Slog:
+		w.Lines(`
+func (defaultLogger) Debug(fields Fields, message string) {
+	slog.Debug(message, attrs(fields)...)
+}
+
+func (defaultLogger) Info(fields Fields, message string) {
+	slog.Info(message, attrs(fields)...)
+}
+
+func (defaultLogger) Warn(fields Fields, message string) {
+	slog.Warn(message, attrs(fields)...)
+}
+
+func (defaultLogger) Error(fields Fields, message string) {
+	slog.Error(message, attrs(fields)...)
+}
+
+func attrs(fields Fields) []any {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]any, 0, len(fields))
+	for _, key := range keys {
+		result = append(result, slog.Any(key, fields[key]))
+	}
+	return result
+}
+`)
+	case Zap:
+		w.Lines(`
+func (defaultLogger) Debug(fields Fields, message string) {
+	zap.L().Debug(message, zapFields(fields)...)
+}
+
+func (defaultLogger) Info(fields Fields, message string) {
+	zap.L().Info(message, zapFields(fields)...)
+}
+
+func (defaultLogger) Warn(fields Fields, message string) {
+	zap.L().Warn(message, zapFields(fields)...)
+}
+
+func (defaultLogger) Error(fields Fields, message string) {
+	zap.L().Error(message, zapFields(fields)...)
+}
+
+func zapFields(fields Fields) []zap.Field {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]zap.Field, 0, len(fields))
+	for _, key := range keys {
+		result = append(result, zap.Any(key, fields[key]))
+	}
+	return result
+}
+`)
+	case None:
+		w.Lines(`
+func (defaultLogger) Debug(fields Fields, message string) {}
+
+func (defaultLogger) Info(fields Fields, message string) {}
+
+func (defaultLogger) Warn(fields Fields, message string) {}
+
+func (defaultLogger) Error(fields Fields, message string) {}
+`)
+	}
+	return w.ToCodeFile()
+}
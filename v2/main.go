@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 	"github.com/specgen-io/specgen-golang/v2/generators"
+	"github.com/specgen-io/specgen-golang/v2/goven/diff"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator/console"
+	"github.com/specgen-io/specgen-golang/v2/goven/importopenapi"
 	"github.com/specgen-io/specgen-golang/v2/version"
 	"github.com/spf13/cobra"
 	"os"
@@ -17,6 +19,8 @@ func main() {
 		Short:   "Code generation based on specification",
 	}
 	generator.AddCobraCommands(rootCmd, generators.All)
+	diff.AddCobraCommand(rootCmd)
+	importopenapi.AddCobraCommand(rootCmd)
 	cobra.OnInitialize()
 	console.PrintLn("Running specgen")
 	if err := rootCmd.Execute(); err != nil {
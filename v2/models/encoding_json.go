@@ -21,26 +21,34 @@ type EncodingJsonGenerator struct {
 	strictMode bool
 }
 
-func (g *EncodingJsonGenerator) Models(version *spec.Version) []generator.CodeFile {
+func (g *EncodingJsonGenerator) Models(version *spec.Version) ([]generator.CodeFile, error) {
 	return g.models(version.ResolvedModels, g.Modules.Models(version))
 }
 
-func (g *EncodingJsonGenerator) ErrorModels(httperrors *spec.HttpErrors) []generator.CodeFile {
+func (g *EncodingJsonGenerator) ErrorModels(httperrors *spec.HttpErrors) ([]generator.CodeFile, error) {
 	return g.models(httperrors.ResolvedModels, g.Modules.HttpErrorsModels)
 }
 
-func (g *EncodingJsonGenerator) models(models []*spec.NamedModel, modelsModule module.Module) []generator.CodeFile {
+func (g *EncodingJsonGenerator) models(models []*spec.NamedModel, modelsModule module.Module) ([]generator.CodeFile, error) {
 	files := []generator.CodeFile{}
 	for _, model := range models {
+		var file *generator.CodeFile
+		var err error
 		if model.IsObject() {
-			files = append(files, *g.objectModel(modelsModule, model))
+			file, err = g.objectModel(modelsModule, model)
 		} else if model.IsOneOf() {
-			files = append(files, *g.oneOfModel(modelsModule, model))
+			file, err = g.oneOfModel(modelsModule, model)
 		} else if model.IsEnum() {
-			files = append(files, *g.enumModel(modelsModule, model))
+			file, err = g.enumModel(modelsModule, model)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if file != nil {
+			files = append(files, *file)
 		}
 	}
-	return files
+	return files, nil
 }
 
 func (g *EncodingJsonGenerator) requiredFieldsList(object *spec.Object) string {
@@ -57,26 +65,12 @@ func (g *EncodingJsonGenerator) requiredFields(model *spec.NamedModel) string {
 	return fmt.Sprintf(`%sRequiredFields`, model.Name.CamelCase())
 }
 
-func (g *EncodingJsonGenerator) objectModel(modelsModule module.Module, model *spec.NamedModel) *generator.CodeFile {
+func (g *EncodingJsonGenerator) objectModel(modelsModule module.Module, model *spec.NamedModel) (*generator.CodeFile, error) {
 	w := writer.New(modelsModule, model.Name.SnakeCase()+`.go`)
-	if g.strictMode {
-		w.Imports.Add("errors")
-		if !walkers.ModelHasType(model, spec.TypeJson) {
-			w.Imports.Add("encoding/json")
-		}
-	}
-	if walkers.ModelHasType(model, spec.TypeJson) {
-		w.Imports.Add("encoding/json")
-	}
-	if walkers.ModelHasType(model, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ModelHasType(model, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ModelHasType(model, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
+	w.Imports.Module(g.Modules.Validation)
 	w.Line("type %s struct {", model.Name.PascalCase())
 	w.Indent()
 	for _, field := range model.Object.Fields {
@@ -140,14 +134,16 @@ func (g *EncodingJsonGenerator) objectModel(modelsModule module.Module, model *s
 		w.Line(`	return nil`)
 		w.Line(`}`)
 	}
+	if walkers.ModelHasConstraints(model) {
+		w.EmptyLine()
+		g.validateMethod(w, model)
+	}
 	return w.ToCodeFile()
 }
 
-func (g *EncodingJsonGenerator) enumModel(modelsModule module.Module, model *spec.NamedModel) *generator.CodeFile {
+func (g *EncodingJsonGenerator) enumModel(modelsModule module.Module, model *spec.NamedModel) (*generator.CodeFile, error) {
 	w := writer.New(modelsModule, model.Name.SnakeCase()+`.go`)
-	if g.strictMode {
-		w.Imports.Module(g.Modules.Enums)
-	}
+	w.Imports.Module(g.Modules.Enums)
 	w.Line("type %s %s", model.Name.PascalCase(), "string")
 	w.EmptyLine()
 	w.Line("const (")
@@ -189,7 +185,7 @@ func (g *EncodingJsonGenerator) enumValues(model *spec.NamedModel) string {
 	return fmt.Sprintf("%sValues", model.Name.PascalCase())
 }
 
-func (g *EncodingJsonGenerator) oneOfModel(modelsModule module.Module, model *spec.NamedModel) *generator.CodeFile {
+func (g *EncodingJsonGenerator) oneOfModel(modelsModule module.Module, model *spec.NamedModel) (*generator.CodeFile, error) {
 	if model.OneOf.Discriminator != nil {
 		return g.oneOfModelDiscriminator(modelsModule, model)
 	} else {
@@ -205,26 +201,11 @@ func (g *EncodingJsonGenerator) getCaseChecks(oneof *spec.OneOf) string {
 	return strings.Join(caseChecks, " && ")
 }
 
-func (g *EncodingJsonGenerator) oneOfModelWrapper(modelsModule module.Module, model *spec.NamedModel) *generator.CodeFile {
+func (g *EncodingJsonGenerator) oneOfModelWrapper(modelsModule module.Module, model *spec.NamedModel) (*generator.CodeFile, error) {
 	w := writer.New(modelsModule, model.Name.SnakeCase()+`.go`)
-	if g.strictMode {
-		w.Imports.Add("errors")
-		if !walkers.ModelHasType(model, spec.TypeJson) {
-			w.Imports.Add("encoding/json")
-		}
-	}
-	if walkers.ModelHasType(model, spec.TypeJson) {
-		w.Imports.Add("encoding/json")
-	}
-	if walkers.ModelHasType(model, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ModelHasType(model, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ModelHasType(model, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
 	w.Line("type %s struct {", model.Name.PascalCase())
 	w.Indent()
 	for _, item := range model.OneOf.Items {
@@ -262,19 +243,11 @@ func (g *EncodingJsonGenerator) oneOfModelWrapper(modelsModule module.Module, mo
 	return w.ToCodeFile()
 }
 
-func (g *EncodingJsonGenerator) oneOfModelDiscriminator(modelsModule module.Module, model *spec.NamedModel) *generator.CodeFile {
+func (g *EncodingJsonGenerator) oneOfModelDiscriminator(modelsModule module.Module, model *spec.NamedModel) (*generator.CodeFile, error) {
 	w := writer.New(modelsModule, model.Name.SnakeCase()+`.go`)
-	w.Imports.Add("errors")
-	w.Imports.Add("encoding/json")
-	if walkers.ModelHasType(model, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ModelHasType(model, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ModelHasType(model, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
 	w.Line("type %s struct {", model.Name.PascalCase())
 	w.Indent()
 	for _, item := range model.OneOf.Items {
@@ -5,7 +5,7 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
-func (g *EncodingJsonGenerator) EnumsHelperFunctions() *generator.CodeFile {
+func (g *EncodingJsonGenerator) EnumsHelperFunctions() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Enums, `helpers.go`)
 	w.Lines(`
 import (
@@ -8,10 +8,11 @@ import (
 )
 
 type Generator interface {
-	Models(version *spec.Version) []generator.CodeFile
-	ErrorModels(httperrors *spec.HttpErrors) []generator.CodeFile
+	Models(version *spec.Version) ([]generator.CodeFile, error)
+	ErrorModels(httperrors *spec.HttpErrors) ([]generator.CodeFile, error)
 	EnumValuesStrings(model *spec.NamedModel) string
-	EnumsHelperFunctions() *generator.CodeFile
+	EnumsHelperFunctions() (*generator.CodeFile, error)
+	ValidationHelperFunctions() (*generator.CodeFile, error)
 }
 
 func NewGenerator(jsonmode string, modules *Modules) Generator {
@@ -5,16 +5,28 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
 )
 
-func GenerateModels(specification *spec.Spec, jsonmode string, moduleName string, generatePath string) *generator.Sources {
+func GenerateModels(specification *spec.Spec, jsonmode string, moduleName string, generatePath string) (*generator.Sources, error) {
 	sources := generator.NewSources()
 
 	modules := NewModules(moduleName, generatePath, specification)
 	generator := NewGenerator(jsonmode, modules)
 
-	sources.AddGenerated(generator.EnumsHelperFunctions())
+	enumsHelperFunctions, err := generator.EnumsHelperFunctions()
+	if err != nil {
+		return nil, err
+	}
+	validationHelperFunctions, err := generator.ValidationHelperFunctions()
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGenerated(enumsHelperFunctions, validationHelperFunctions)
 
 	for _, version := range specification.Versions {
-		sources.AddGeneratedAll(generator.Models(&version))
+		models, err := generator.Models(&version)
+		if err != nil {
+			return nil, err
+		}
+		sources.AddGeneratedAll(models)
 	}
-	return sources
+	return sources, nil
 }
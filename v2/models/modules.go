@@ -12,6 +12,7 @@ type Modules struct {
 	Enums            module.Module
 	HttpErrors       module.Module
 	HttpErrorsModels module.Module
+	Validation       module.Module
 }
 
 func NewModules(moduleName string, generatePath string, specification *spec.Spec) *Modules {
@@ -19,6 +20,7 @@ func NewModules(moduleName string, generatePath string, specification *spec.Spec
 	enums := generated.Submodule("enums")
 	httperrors := generated.Submodule("httperrors")
 	httperrorsModels := httperrors.Submodule(types.ErrorsModelsPackage)
+	validation := generated.Submodule("validation")
 
 	models := map[string]module.Module{}
 	for _, version := range specification.Versions {
@@ -31,6 +33,7 @@ func NewModules(moduleName string, generatePath string, specification *spec.Spec
 		enums,
 		httperrors,
 		httperrorsModels,
+		validation,
 	}
 }
 
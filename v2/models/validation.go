@@ -0,0 +1,233 @@
+package models
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/walkers"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"strconv"
+	"strings"
+)
+
+func constraintsCalls(typ *spec.TypeDef, constraints spec.Constraints, path string, valueVar string) []string {
+	calls := []string{}
+	baseType := typ.BaseType()
+	min, max, pattern := constraints.Min(), constraints.Max(), constraints.Pattern()
+	if baseType.Node == spec.ArrayType {
+		if min != nil {
+			calls = append(calls, fmt.Sprintf(`validation.MinItems("%s", len(%s), %s)`, path, valueVar, *min))
+		}
+		if max != nil {
+			calls = append(calls, fmt.Sprintf(`validation.MaxItems("%s", len(%s), %s)`, path, valueVar, *max))
+		}
+		return calls
+	}
+	if typ.IsNullable() {
+		valueVar = "*" + valueVar
+	}
+	if baseType.Plain == spec.TypeString {
+		if min != nil {
+			calls = append(calls, fmt.Sprintf(`validation.MinLength("%s", %s, %s)`, path, valueVar, *min))
+		}
+		if max != nil {
+			calls = append(calls, fmt.Sprintf(`validation.MaxLength("%s", %s, %s)`, path, valueVar, *max))
+		}
+		if pattern != nil {
+			calls = append(calls, fmt.Sprintf(`validation.Pattern("%s", %s, %s)`, path, valueVar, strconv.Quote(*pattern)))
+		}
+		return calls
+	}
+	numberVar := fmt.Sprintf(`float64(%s)`, valueVar)
+	if baseType.Plain == spec.TypeDecimal {
+		numberVar = fmt.Sprintf(`%s.InexactFloat64()`, strings.TrimPrefix(valueVar, "*"))
+	}
+	if min != nil {
+		calls = append(calls, fmt.Sprintf(`validation.Min("%s", %s, %s)`, path, numberVar, *min))
+	}
+	if max != nil {
+		calls = append(calls, fmt.Sprintf(`validation.Max("%s", %s, %s)`, path, numberVar, *max))
+	}
+	return calls
+}
+
+func WriteConstraintsChecks(w *writer.Writer, checkerVar string, typ *spec.TypeDef, constraints spec.Constraints, path string, valueVar string) {
+	calls := constraintsCalls(typ, constraints, path, valueVar)
+	if len(calls) == 0 {
+		return
+	}
+	if typ.IsNullable() {
+		w.Line(`if %s != nil {`, valueVar)
+		for _, call := range calls {
+			w.Line(`  %s.Check(%s)`, checkerVar, call)
+		}
+		w.Line(`}`)
+	} else {
+		for _, call := range calls {
+			w.Line(`%s.Check(%s)`, checkerVar, call)
+		}
+	}
+}
+
+func (g *EncodingJsonGenerator) validateMethod(w *writer.Writer, model *spec.NamedModel) {
+	w.Line(`func (obj *%s) Validate() error {`, model.Name.PascalCase())
+	w.Line(`  validator := validation.Validator{}`)
+	for _, field := range model.Object.Fields {
+		fieldVar := fmt.Sprintf(`obj.%s`, field.Name.PascalCase())
+		WriteConstraintsChecks(w.Indented(), "validator", &field.Type.Definition, field.Constraints, field.Name.Source, fieldVar)
+		typ := &field.Type.Definition
+		fieldModel := typ.BaseType().Info.Model
+		if typ.BaseType().Node == spec.ArrayType {
+			fieldModel = typ.BaseType().Child.Info.Model
+		}
+		if fieldModel == nil || !walkers.ModelHasConstraints(fieldModel) {
+			continue
+		}
+		switch typ.BaseType().Node {
+		case spec.PlainType:
+			if typ.IsNullable() {
+				w.Line(`  if %s != nil {`, fieldVar)
+				w.Line(`    validator.Nested("%s", %s.Validate())`, field.Name.Source, fieldVar)
+				w.Line(`  }`)
+			} else {
+				w.Line(`  validator.Nested("%s", %s.Validate())`, field.Name.Source, fieldVar)
+			}
+		case spec.ArrayType:
+			w.Line(`  for index := range %s {`, fieldVar)
+			w.Line(`    validator.NestedItem("%s", index, %s[index].Validate())`, field.Name.Source, fieldVar)
+			w.Line(`  }`)
+		}
+	}
+	w.Line(`  return validator.Result()`)
+	w.Line(`}`)
+}
+
+func (g *EncodingJsonGenerator) ValidationHelperFunctions() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Validation, `validation.go`)
+	w.Lines(`
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+type Error struct {
+	Path    string
+	Code    string
+	Message *string
+}
+
+type Errors []Error
+
+func (errors Errors) Error() string {
+	messages := []string{}
+	for _, err := range errors {
+		message := err.Code
+		if err.Message != nil {
+			message = *err.Message
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", err.Path, message))
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+func newError(path string, code string, format string, args ...interface{}) *Error {
+	message := fmt.Sprintf(format, args...)
+	return &Error{path, code, &message}
+}
+
+func MinLength(path string, value string, min int) *Error {
+	if utf8.RuneCountInString(value) < min {
+		return newError(path, "min_length", "length should be at least %d", min)
+	}
+	return nil
+}
+
+func MaxLength(path string, value string, max int) *Error {
+	if utf8.RuneCountInString(value) > max {
+		return newError(path, "max_length", "length should be at most %d", max)
+	}
+	return nil
+}
+
+var patterns sync.Map
+
+func Pattern(path string, value string, pattern string) *Error {
+	compiled, found := patterns.Load(pattern)
+	if !found {
+		compiled, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !compiled.(*regexp.Regexp).MatchString(value) {
+		return newError(path, "pattern", "should match pattern %s", pattern)
+	}
+	return nil
+}
+
+func Min(path string, value float64, min float64) *Error {
+	if value < min {
+		return newError(path, "min", "should be at least %v", min)
+	}
+	return nil
+}
+
+func Max(path string, value float64, max float64) *Error {
+	if value > max {
+		return newError(path, "max", "should be at most %v", max)
+	}
+	return nil
+}
+
+func MinItems(path string, length int, min int) *Error {
+	if length < min {
+		return newError(path, "min_items", "should have at least %d items", min)
+	}
+	return nil
+}
+
+func MaxItems(path string, length int, max int) *Error {
+	if length > max {
+		return newError(path, "max_items", "should have at most %d items", max)
+	}
+	return nil
+}
+
+type Validator struct {
+	Errors Errors
+}
+
+func (validator *Validator) Check(err *Error) {
+	if err != nil {
+		validator.Errors = append(validator.Errors, *err)
+	}
+}
+
+func (validator *Validator) Nested(path string, err error) {
+	if err == nil {
+		return
+	}
+	if errors, ok := err.(Errors); ok {
+		for _, nested := range errors {
+			nested.Path = path + "." + nested.Path
+			validator.Errors = append(validator.Errors, nested)
+		}
+	} else {
+		message := err.Error()
+		validator.Errors = append(validator.Errors, Error{path, "invalid", &message})
+	}
+}
+
+func (validator *Validator) NestedItem(path string, index int, err error) {
+	validator.Nested(fmt.Sprintf("%s[%d]", path, index), err)
+}
+
+func (validator *Validator) Result() error {
+	if len(validator.Errors) > 0 {
+		return validator.Errors
+	}
+	return nil
+}
+`)
+	return w.ToCodeFile()
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"fmt"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"strings"
+)
+
+const authenticatorVar = "authenticator"
+
+func authenticatorMethodName(scheme *spec.NamedSecurityScheme) string {
+	return scheme.Name.PascalCase()
+}
+
+func requireMethodName(scheme *spec.NamedSecurityScheme) string {
+	return fmt.Sprintf(`Require%s`, scheme.Name.PascalCase())
+}
+
+func authenticatorCheck(w *writer.Writer, api *spec.Api) {
+	w.Line(`if %s == nil {`, authenticatorVar)
+	w.Line(`  panic("authenticator is required for secured operations of %s api")`, api.Name.Source)
+	w.Line(`}`)
+}
+
+func authentication(w *writer.Writer, operation *spec.NamedOperation, types *types.Types) {
+	requirements := []string{}
+	for _, ref := range operation.EffectiveSecurity() {
+		args := []string{}
+		for _, arg := range ref.Args {
+			args = append(args, fmt.Sprintf(`"%s"`, arg))
+		}
+		requirements = append(requirements, fmt.Sprintf(`auth.%s(%s)`, requireMethodName(ref.Scheme), strings.Join(args, ", ")))
+	}
+	w.Line(`authCtx, err := auth.Authenticate(%s, req, %s)`, authenticatorVar, strings.Join(requirements, ", "))
+	w.Line(`if auth.IsUnauthenticated(err) {`)
+	w.Line(`  logging.Warn(%s, err.Error())`, logFieldsName(operation))
+	w.Line(`  %s`, respondEmpty(logFieldsName(operation), `res`, `http.StatusUnauthorized`))
+	w.Line(`  return`)
+	w.Line(`}`)
+	w.Line(`if err != nil {`)
+	respondError(w.Indented(), operation, types, `"Error returned from authenticator"`)
+	w.Line(`}`)
+	w.Line(`req = req.WithContext(authCtx)`)
+}
+
+func (g *Generator) Auth(schemes spec.SecuritySchemes) (*generator.CodeFile, error) {
+	if len(schemes) == 0 {
+		return nil, nil
+	}
+
+	w := writer.New(g.Modules.Auth, `auth.go`)
+
+	w.Line(`var ErrMissingCredentials = errors.New("missing credentials")`)
+	w.Line(`var ErrInvalidCredentials = errors.New("invalid credentials")`)
+	w.EmptyLine()
+	w.Line(`func IsUnauthenticated(err error) bool {`)
+	w.Line(`  return errors.Is(err, ErrMissingCredentials) || errors.Is(err, ErrInvalidCredentials)`)
+	w.Line(`}`)
+	w.EmptyLine()
+	w.Line(`type Authenticator interface {`)
+	for _, scheme := range schemes {
+		switch scheme.Type {
+		case spec.SecurityBearer:
+			w.Line(`  %s(ctx context.Context, token string, scopes []string) (context.Context, error)`, authenticatorMethodName(&scheme))
+		case spec.SecurityBasic:
+			w.Line(`  %s(ctx context.Context, username string, password string, scopes []string) (context.Context, error)`, authenticatorMethodName(&scheme))
+		case spec.SecurityApiKey:
+			w.Line(`  %s(ctx context.Context, apiKey string, scopes []string) (context.Context, error)`, authenticatorMethodName(&scheme))
+		}
+	}
+	w.Line(`}`)
+	w.EmptyLine()
+	w.Line(`type Requirement func(authenticator Authenticator, req *http.Request) (context.Context, error)`)
+	for _, scheme := range schemes {
+		w.EmptyLine()
+		w.Line(`func %s(scopes ...string) Requirement {`, requireMethodName(&scheme))
+		w.Line(`  return func(authenticator Authenticator, req *http.Request) (context.Context, error) {`)
+		switch scheme.Type {
+		case spec.SecurityBearer:
+			w.Line(`    scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")`)
+			w.Line(`    if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {`)
+			w.Line(`      return nil, ErrMissingCredentials`)
+			w.Line(`    }`)
+			w.Line(`    return authenticator.%s(req.Context(), token, scopes)`, authenticatorMethodName(&scheme))
+		case spec.SecurityBasic:
+			w.Line(`    username, password, ok := req.BasicAuth()`)
+			w.Line(`    if !ok {`)
+			w.Line(`      return nil, ErrMissingCredentials`)
+			w.Line(`    }`)
+			w.Line(`    return authenticator.%s(req.Context(), username, password, scopes)`, authenticatorMethodName(&scheme))
+		case spec.SecurityApiKey:
+			if *scheme.In == spec.ApiKeyInQuery {
+				w.Line(`    apiKey := req.URL.Query().Get("%s")`, *scheme.KeyName)
+			} else {
+				w.Line(`    apiKey := req.Header.Get("%s")`, *scheme.KeyName)
+			}
+			w.Line(`    if apiKey == "" {`)
+			w.Line(`      return nil, ErrMissingCredentials`)
+			w.Line(`    }`)
+			w.Line(`    return authenticator.%s(req.Context(), apiKey, scopes)`, authenticatorMethodName(&scheme))
+		}
+		w.Line(`  }`)
+		w.Line(`}`)
+	}
+	w.EmptyLine()
+	w.Lines(`
+func Authenticate(authenticator Authenticator, req *http.Request, requirements ...Requirement) (context.Context, error) {
+	var err error = ErrMissingCredentials
+	for _, requirement := range requirements {
+		ctx, requirementErr := requirement(authenticator, req)
+		if requirementErr == nil {
+			if ctx == nil {
+				ctx = req.Context()
+			}
+			return ctx, nil
+		}
+		if errors.Is(err, ErrMissingCredentials) {
+			err = requirementErr
+		}
+	}
+	return nil, err
+}
+`)
+	return w.ToCodeFile()
+}
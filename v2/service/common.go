@@ -61,13 +61,16 @@ func apiPackageAlias(api *spec.Api) string {
 }
 
 func serviceCall(serviceVar string, operation *spec.NamedOperation) string {
-	params := []string{}
+	params := []string{"req.Context()"}
 	if operation.BodyIs(spec.RequestBodyString) {
 		params = append(params, "body")
 	}
 	if operation.BodyIs(spec.RequestBodyJson) {
 		params = append(params, "&body")
 	}
+	if operation.BodyIs(spec.RequestBodyBinary) {
+		params = append(params, "req.Body")
+	}
 	if operation.BodyIs(spec.RequestBodyFormData) {
 		for _, param := range operation.Body.FormData {
 			params = append(params, param.Name.CamelCase())
@@ -84,30 +87,12 @@ func serviceCall(serviceVar string, operation *spec.NamedOperation) string {
 	for _, param := range operation.HeaderParams {
 		params = append(params, param.Name.CamelCase())
 	}
+	for _, param := range operation.CookieParams {
+		params = append(params, param.Name.CamelCase())
+	}
 	for _, param := range operation.Endpoint.UrlParams {
 		params = append(params, param.Name.CamelCase())
 	}
 
 	return fmt.Sprintf(`%s.%s(%s)`, serviceVar, operation.Name.PascalCase(), strings.Join(params, ", "))
 }
-
-func operationHasParams(api *spec.Api) bool {
-	for _, operation := range api.Operations {
-		for _, param := range operation.QueryParams {
-			if &param != nil {
-				return true
-			}
-		}
-		for _, param := range operation.HeaderParams {
-			if &param != nil {
-				return true
-			}
-		}
-		for _, param := range operation.Endpoint.UrlParams {
-			if &param != nil {
-				return true
-			}
-		}
-	}
-	return false
-}
@@ -14,6 +14,8 @@ func ContentType(operation *spec.NamedOperation) string {
 		return "text/plain"
 	} else if operation.BodyIs(spec.RequestBodyJson) {
 		return "application/json"
+	} else if operation.BodyIs(spec.RequestBodyBinary) {
+		return "application/octet-stream"
 	} else if operation.BodyIs(spec.RequestBodyFormData) {
 		return "multipart/form-data"
 	} else if operation.BodyIs(spec.RequestBodyFormUrlEncoded) {
@@ -23,23 +25,24 @@ func ContentType(operation *spec.NamedOperation) string {
 	}
 }
 
-func (g *Generator) CheckContentType() *generator.CodeFile {
+func (g *Generator) CheckContentType() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ContentType, `check.go`)
 	w.Template(
 		map[string]string{
 			`ErrorsPackage`:       g.Modules.HttpErrors.Package,
 			`ErrorsModelsPackage`: g.Modules.HttpErrorsModels.Package,
+			`LoggingPackage`:      g.Modules.Logging.Package,
 		}, `
 import (
 	"fmt"
-	log "github.com/sirupsen/logrus"
 	"[[.ErrorsPackage]]"
 	"[[.ErrorsModelsPackage]]"
+	"[[.LoggingPackage]]"
 	"net/http"
 	"strings"
 )
 
-func Check(logFields log.Fields, expectedContentType string, req *http.Request, res http.ResponseWriter) bool {
+func Check(logFields logging.Fields, expectedContentType string, req *http.Request, res http.ResponseWriter) bool {
 	contentType := req.Header.Get("Content-Type")
 	if !strings.Contains(contentType, expectedContentType) {
 		message := fmt.Sprintf("Expected Content-Type header: '%s' was not provided, found: '%s'", expectedContentType, contentType)
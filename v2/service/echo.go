@@ -0,0 +1,86 @@
+package service
+
+import (
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+var Echo = "echo"
+
+type EchoGenerator struct {
+	*routing
+}
+
+func NewEchoGenerator(types *types.Types, models models.Generator, modules *Modules) *EchoGenerator {
+	g := &EchoGenerator{}
+	g.routing = newRouting(types, models, modules, g)
+	return g
+}
+
+func (g *EchoGenerator) routerType() string {
+	return `*echov4.Echo`
+}
+
+func (g *EchoGenerator) importRouter(w *writer.Writer) {
+	w.Imports.AddAliased("github.com/labstack/echo/v4", "echov4")
+}
+
+func (g *EchoGenerator) endpointUrl(operation *spec.NamedOperation) string {
+	return endpointUrl(operation, ":%s")
+}
+
+func (g *EchoGenerator) addRoute(w *writer.Writer, operation *spec.NamedOperation, url string, handler func(w *writer.Writer)) {
+	w.Line(`%s := %s`, operationHandlerName(operation), applyMiddlewares(operation))
+	handler(w.Indented())
+	w.Line(`})`)
+	w.Line(`router.Add("%s", "%s", func(ctx echov4.Context) error {`, casee.ToUpperCase(operation.Endpoint.Method), url)
+	if len(operation.Endpoint.UrlParams) > 0 {
+		w.Line(`  %s(ctx.Response(), paramsparser.WithUrlParams(ctx.Request(), ctx))`, operationHandlerName(operation))
+	} else {
+		w.Line(`  %s(ctx.Response(), ctx.Request())`, operationHandlerName(operation))
+	}
+	w.Line(`  return nil`)
+	w.Line(`})`)
+}
+
+func (g *EchoGenerator) urlParamsParser(operation *spec.NamedOperation) string {
+	return `paramsparser.NewUrlParser(req.Context(), false)`
+}
+
+func (g *EchoGenerator) urlParamName(param *spec.NamedParam) string {
+	return param.Name.Source
+}
+
+func (g *EchoGenerator) GenerateUrlParamsCtor() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.ParamsParser, `url_parser.go`)
+
+	w.Lines(`
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/url"
+)
+
+type urlParamsKey struct{}
+
+func WithUrlParams(req *http.Request, ctx echo.Context) *http.Request {
+	values := make(url.Values)
+	for _, name := range ctx.ParamNames() {
+		values.Add(name, ctx.Param(name))
+	}
+	return req.WithContext(context.WithValue(req.Context(), urlParamsKey{}, values))
+}
+
+func NewUrlParser(context context.Context, parseCommaSeparatedArray bool) *ParamsParser {
+	values, _ := context.Value(urlParamsKey{}).(url.Values)
+	return &ParamsParser{values, parseCommaSeparatedArray, []ParsingError{}}
+}
+`)
+
+	return w.ToCodeFile()
+}
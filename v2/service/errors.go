@@ -8,6 +8,8 @@ import (
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
+const errorMapperVar = "errorMapper"
+
 func respondNotFound(w *writer.Writer, operation *spec.NamedOperation, types *types.Types, message string) {
 	specification := operation.InApi.InHttp.InVersion.InSpec
 	badRequest := specification.HttpErrors.Responses.GetByStatusName(spec.HttpStatusNotFound)
@@ -32,25 +34,48 @@ func respondInternalServerError(w *writer.Writer, operation *spec.NamedOperation
 	w.Line(`return`)
 }
 
-func (g *Generator) HttpErrors(responses *spec.ErrorResponses) []generator.CodeFile {
-	files := []generator.CodeFile{}
+func respondServiceError(w *writer.Writer, operation *spec.NamedOperation, types *types.Types) {
+	respondError(w, operation, types, `"Error returned from service implementation"`)
+}
 
-	files = append(files, *g.errorsModelsConverter())
-	files = append(files, *g.ErrorResponses(responses))
+func respondError(w *writer.Writer, operation *spec.NamedOperation, types *types.Types, logMessage string) {
+	w.Line(`if httpError := httperrors.Find(err, %s); httpError != nil {`, errorMapperVar)
+	w.Line(`  httpError.Respond(%s, res)`, logFieldsName(operation))
+	w.Line(`  return`)
+	w.Line(`}`)
+	w.Line(`logging.Error(%s.With("error", err.Error()), %s)`, logFieldsName(operation), logMessage)
+	respondInternalServerError(w, operation, types, `"Internal server error"`)
+}
 
-	return files
+func (g *Generator) HttpErrors(responses *spec.ErrorResponses) ([]generator.CodeFile, error) {
+	errorsModelsConverter, err := g.errorsModelsConverter()
+	if err != nil {
+		return nil, err
+	}
+	errorResponses, err := g.ErrorResponses(responses)
+	if err != nil {
+		return nil, err
+	}
+	errorTypes, err := g.errorTypes(responses)
+	if err != nil {
+		return nil, err
+	}
+
+	return []generator.CodeFile{*errorsModelsConverter, *errorResponses, *errorTypes}, nil
 }
 
-func (g *Generator) errorsModelsConverter() *generator.CodeFile {
+func (g *Generator) errorsModelsConverter() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.HttpErrors, `params.go`)
 	w.Template(
 		map[string]string{
 			`ErrorsModelsPackage`: g.Modules.HttpErrorsModels.Package,
 			`ParamsParserModule`:  g.Modules.ParamsParser.Package,
+			`ValidationModule`:    g.Modules.Validation.Package,
 		}, `
 import (
 	"[[.ErrorsModelsPackage]]"
 	"[[.ParamsParserModule]]"
+	"[[.ValidationModule]]"
 )
 
 func Convert(parsingErrors []paramsparser.ParsingError) []errmodels.ValidationError {
@@ -63,25 +88,40 @@ func Convert(parsingErrors []paramsparser.ParsingError) []errmodels.ValidationEr
 
 	return validationErrors
 }
+
+func ConvertValidation(err error) []errmodels.ValidationError {
+	var validationErrors []errmodels.ValidationError
+
+	if errors, ok := err.(validation.Errors); ok {
+		for _, validationError := range errors {
+			validationErrors = append(validationErrors, errmodels.ValidationError(validationError))
+		}
+	}
+
+	return validationErrors
+}
 `)
 	return w.ToCodeFile()
 }
 
-func (g *Generator) ErrorResponses(errors *spec.ErrorResponses) *generator.CodeFile {
+func (g *Generator) ErrorResponses(errors *spec.ErrorResponses) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.HttpErrors, "responses.go")
 
-	w.Imports.AddAliased("github.com/sirupsen/logrus", "log")
-	w.Imports.Add("net/http")
+	w.Imports.Module(g.Modules.Logging)
 	w.Imports.Module(g.Modules.HttpErrorsModels)
 	w.Imports.Module(g.Modules.Respond)
 
 	for _, response := range *errors {
 		if response.Body.Is(spec.ResponseBodyEmpty) {
-			w.Line(`func Respond%s(logFields log.Fields, res http.ResponseWriter) {`, response.Name.PascalCase())
-			w.Line(`  log.WithFields(logFields).Warn("")`)
+			w.Line(`func Respond%s(logFields logging.Fields, res http.ResponseWriter) {`, response.Name.PascalCase())
+			w.Line(`  logging.Warn(logFields, "")`)
 		} else {
-			w.Line(`func Respond%s(logFields log.Fields, res http.ResponseWriter, error *%s) {`, response.Name.PascalCase(), g.Types.GoType(&response.Body.Type.Definition))
-			w.Line(`  log.WithFields(logFields).Warn(error.Message)`)
+			w.Line(`func Respond%s(logFields logging.Fields, res http.ResponseWriter, error *%s) {`, response.Name.PascalCase(), g.Types.GoType(&response.Body.Type.Definition))
+			if hasMessageField(&response.Body.Type.Definition) {
+				w.Line(`  logging.Warn(logFields, error.Message)`)
+			} else {
+				w.Line(`  logging.Warn(logFields, "Error response")`)
+			}
 		}
 		writeResponse(w.Indented(), `logFields`, &response.Response, `error`)
 		w.Line(`}`)
@@ -90,3 +130,65 @@ func (g *Generator) ErrorResponses(errors *spec.ErrorResponses) *generator.CodeF
 
 	return w.ToCodeFile()
 }
+
+func hasMessageField(typ *spec.TypeDef) bool {
+	if typ.Node != spec.PlainType || typ.Info == nil || typ.Info.Model == nil || !typ.Info.Model.IsObject() {
+		return false
+	}
+	for _, field := range typ.Info.Model.Object.Fields {
+		definition := &field.Type.Definition
+		if field.Name.Source == "message" && definition.Node == spec.PlainType && definition.Plain == spec.TypeString {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) errorTypes(errors *spec.ErrorResponses) (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.HttpErrors, "errors.go")
+
+	w.Imports.Module(g.Modules.HttpErrorsModels)
+	w.Imports.Module(g.Modules.Logging)
+
+	w.Line(`type Error interface {`)
+	w.Line(`  error`)
+	w.Line(`  Respond(logFields logging.Fields, res http.ResponseWriter)`)
+	w.Line(`}`)
+	w.EmptyLine()
+	w.Line(`type Mapper func(err error) Error`)
+	w.EmptyLine()
+	w.Line(`func Find(err error, mapper Mapper) Error {`)
+	w.Line(`  var httpError Error`)
+	w.Line(`  if errors.As(err, &httpError) {`)
+	w.Line(`    return httpError`)
+	w.Line(`  }`)
+	w.Line(`  if mapper != nil {`)
+	w.Line(`    return mapper(err)`)
+	w.Line(`  }`)
+	w.Line(`  return nil`)
+	w.Line(`}`)
+
+	for _, response := range *errors {
+		typeName := response.Name.PascalCase()
+		w.EmptyLine()
+		if response.Body.Is(spec.ResponseBodyEmpty) {
+			w.Line(`type %s struct{}`, typeName)
+		} else {
+			w.Line(`type %s %s`, typeName, g.Types.GoType(&response.Body.Type.Definition))
+		}
+		w.EmptyLine()
+		w.Line(`func (e *%s) Error() string {`, typeName)
+		w.Line(`  return http.StatusText(%s)`, spec.HttpStatusCode(response.Name))
+		w.Line(`}`)
+		w.EmptyLine()
+		w.Line(`func (e *%s) Respond(logFields logging.Fields, res http.ResponseWriter) {`, typeName)
+		if response.Body.Is(spec.ResponseBodyEmpty) {
+			w.Line(`  Respond%s(logFields, res)`, typeName)
+		} else {
+			w.Line(`  Respond%s(logFields, res, (*%s)(e))`, typeName, g.Types.GoType(&response.Body.Type.Definition))
+		}
+		w.Line(`}`)
+	}
+
+	return w.ToCodeFile()
+}
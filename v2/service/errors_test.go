@@ -0,0 +1,92 @@
+package service
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/module"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+const pingSpec = `
+spec: 2.1
+name: bla-api
+version: 0
+
+http:
+  test:
+    ping:
+      endpoint: GET /ping
+      response:
+        ok: empty
+`
+
+func readSpec(t *testing.T) *spec.Spec {
+	specification, _, err := spec.ReadSpec([]byte(pingSpec))
+	assert.NilError(t, err)
+	return specification
+}
+
+func TestRespondServiceErrorUsesMapper(t *testing.T) {
+	operation := &readSpec(t).Versions[0].Http.Apis[0].Operations[0]
+	w := writer.New(module.New("bla", "routing"), "routing.go")
+	respondServiceError(w, operation, types.NewTypes())
+
+	expected := `
+if httpError := httperrors.Find(err, errorMapper); httpError != nil {
+	httpError.Respond(logPing, res)
+	return
+}
+logging.Error(logPing.With("error", err.Error()), "Error returned from service implementation")
+httperrors.RespondInternalServerError(logPing, res, &errmodels.InternalServerError{Message: "Internal server error"})
+return
+`
+	assert.Equal(t, strings.TrimSpace(w.String()), strings.TrimSpace(expected))
+}
+
+func TestErrorTypesFindTakesMapper(t *testing.T) {
+	specification := readSpec(t)
+	modules := NewModules("bla", "spec", "services", specification)
+	g := NewGenerator("strict", Chi, modules)
+
+	file, err := g.errorTypes(&specification.HttpErrors.Responses)
+	assert.NilError(t, err)
+	code := file.Content
+	assert.Assert(t, strings.Contains(code, "type Mapper func(err error) Error"))
+	expectedFind := `
+func Find(err error, mapper Mapper) Error {
+	var httpError Error
+	if errors.As(err, &httpError) {
+		return httpError
+	}
+	if mapper != nil {
+		return mapper(err)
+	}
+	return nil
+}
+`
+	assert.Assert(t, strings.Contains(code, strings.TrimSpace(expectedFind)))
+}
+
+func TestErrorResponsesCustomModelWithoutMessage(t *testing.T) {
+	specification, _, err := spec.ReadSpec([]byte(pingSpec + `
+errors:
+  responses:
+    conflict: ConflictError
+  models:
+    ConflictError:
+      object:
+        reason: string
+`))
+	assert.NilError(t, err)
+	modules := NewModules("bla", "spec", "services", specification)
+	g := NewGenerator("strict", Chi, modules)
+
+	file, err := g.ErrorResponses(&specification.HttpErrors.Responses)
+	assert.NilError(t, err)
+	code := file.Content
+	assert.Assert(t, strings.Contains(code, "logging.Warn(logFields, error.Message)"))
+	assert.Assert(t, strings.Contains(code, "func RespondConflict(logFields logging.Fields, res http.ResponseWriter, error *errmodels.ConflictError) {\n\tlogging.Warn(logFields, \"Error response\")"))
+}
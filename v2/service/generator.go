@@ -9,9 +9,9 @@ import (
 )
 
 type ServerGenerator interface {
-	RootRouting(specification *spec.Spec) *generator.CodeFile
-	Routings(version *spec.Version) []generator.CodeFile
-	GenerateUrlParamsCtor() *generator.CodeFile
+	RootRouting(specification *spec.Spec) (*generator.CodeFile, error)
+	Routings(version *spec.Version) ([]generator.CodeFile, error)
+	GenerateUrlParamsCtor() (*generator.CodeFile, error)
 }
 
 type Generator struct {
@@ -36,6 +36,18 @@ func NewGenerator(jsonmode, server string, modules *Modules) *Generator {
 	case Chi:
 		serverGenerator = NewChiGenerator(types, models, modules)
 		break
+	case Stdlib:
+		serverGenerator = NewStdlibGenerator(types, models, modules)
+		break
+	case Gin:
+		serverGenerator = NewGinGenerator(types, models, modules)
+		break
+	case Echo:
+		serverGenerator = NewEchoGenerator(types, models, modules)
+		break
+	case GorillaMux:
+		serverGenerator = NewGorillaMuxGenerator(types, models, modules)
+		break
 	default:
 		panic(fmt.Sprintf(`Unsupported server: %s`, server))
 	}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+var Gin = "gin"
+
+type GinGenerator struct {
+	*routing
+}
+
+func NewGinGenerator(types *types.Types, models models.Generator, modules *Modules) *GinGenerator {
+	g := &GinGenerator{}
+	g.routing = newRouting(types, models, modules, g)
+	return g
+}
+
+func (g *GinGenerator) routerType() string {
+	return `*gin.Engine`
+}
+
+func (g *GinGenerator) importRouter(w *writer.Writer) {
+	w.Imports.Add("github.com/gin-gonic/gin")
+}
+
+func (g *GinGenerator) endpointUrl(operation *spec.NamedOperation) string {
+	return endpointUrl(operation, ":%s")
+}
+
+func (g *GinGenerator) addRoute(w *writer.Writer, operation *spec.NamedOperation, url string, handler func(w *writer.Writer)) {
+	w.Line(`%s := %s`, operationHandlerName(operation), applyMiddlewares(operation))
+	handler(w.Indented())
+	w.Line(`})`)
+	w.Line(`router.Handle("%s", "%s", func(ctx *gin.Context) {`, casee.ToUpperCase(operation.Endpoint.Method), url)
+	if len(operation.Endpoint.UrlParams) > 0 {
+		w.Line(`  %s(ctx.Writer, paramsparser.WithUrlParams(ctx.Request, ctx.Params))`, operationHandlerName(operation))
+	} else {
+		w.Line(`  %s(ctx.Writer, ctx.Request)`, operationHandlerName(operation))
+	}
+	w.Line(`})`)
+}
+
+func (g *GinGenerator) urlParamsParser(operation *spec.NamedOperation) string {
+	return `paramsparser.NewUrlParser(req.Context(), false)`
+}
+
+func (g *GinGenerator) urlParamName(param *spec.NamedParam) string {
+	return param.Name.Source
+}
+
+func (g *GinGenerator) GenerateUrlParamsCtor() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.ParamsParser, `url_parser.go`)
+
+	w.Lines(`
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/url"
+)
+
+type urlParamsKey struct{}
+
+func WithUrlParams(req *http.Request, params gin.Params) *http.Request {
+	values := make(url.Values)
+	for _, param := range params {
+		values.Add(param.Key, param.Value)
+	}
+	return req.WithContext(context.WithValue(req.Context(), urlParamsKey{}, values))
+}
+
+func NewUrlParser(context context.Context, parseCommaSeparatedArray bool) *ParamsParser {
+	values, _ := context.Value(urlParamsKey{}).(url.Values)
+	return &ParamsParser{values, parseCommaSeparatedArray, []ParsingError{}}
+}
+`)
+
+	return w.ToCodeFile()
+}
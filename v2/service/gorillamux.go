@@ -0,0 +1,70 @@
+package service
+
+import (
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+var GorillaMux = "gorillamux"
+
+type GorillaMuxGenerator struct {
+	*routing
+}
+
+func NewGorillaMuxGenerator(types *types.Types, models models.Generator, modules *Modules) *GorillaMuxGenerator {
+	g := &GorillaMuxGenerator{}
+	g.routing = newRouting(types, models, modules, g)
+	return g
+}
+
+func (g *GorillaMuxGenerator) routerType() string {
+	return `*mux.Router`
+}
+
+func (g *GorillaMuxGenerator) importRouter(w *writer.Writer) {
+	w.Imports.Add("github.com/gorilla/mux")
+}
+
+func (g *GorillaMuxGenerator) endpointUrl(operation *spec.NamedOperation) string {
+	return endpointUrl(operation, "{%s}")
+}
+
+func (g *GorillaMuxGenerator) addRoute(w *writer.Writer, operation *spec.NamedOperation, url string, handler func(w *writer.Writer)) {
+	w.Line(`router.HandleFunc("%s", %s`, url, applyMiddlewares(operation))
+	handler(w.Indented())
+	w.Line(`})).Methods("%s")`, casee.ToUpperCase(operation.Endpoint.Method))
+}
+
+func (g *GorillaMuxGenerator) urlParamsParser(operation *spec.NamedOperation) string {
+	return `paramsparser.NewUrlParser(req, false)`
+}
+
+func (g *GorillaMuxGenerator) urlParamName(param *spec.NamedParam) string {
+	return param.Name.Source
+}
+
+func (g *GorillaMuxGenerator) GenerateUrlParamsCtor() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.ParamsParser, `url_parser.go`)
+
+	w.Lines(`
+import (
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/url"
+)
+
+func NewUrlParser(req *http.Request, parseCommaSeparatedArray bool) *ParamsParser {
+	values := make(url.Values)
+	for name, value := range mux.Vars(req) {
+		values.Add(name, value)
+	}
+	return &ParamsParser{values, parseCommaSeparatedArray, []ParsingError{}}
+}
+`)
+
+	return w.ToCodeFile()
+}
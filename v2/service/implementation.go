@@ -4,48 +4,37 @@ import (
 	"fmt"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
-	"github.com/specgen-io/specgen-golang/v2/walkers"
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
-func (g *Generator) ServicesImpls(version *spec.Version) []generator.CodeFile {
+func (g *Generator) ServicesImpls(version *spec.Version) ([]generator.CodeFile, error) {
 	files := []generator.CodeFile{}
 	for _, api := range version.Http.Apis {
-		files = append(files, *g.serviceImpl(&api))
+		file, err := g.serviceImpl(&api)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
 	}
-	return files
+	return files, nil
 }
 
-func (g *Generator) serviceImpl(api *spec.Api) *generator.CodeFile {
+func (g *Generator) serviceImpl(api *spec.Api) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ServicesImpl(api.InHttp.InVersion), fmt.Sprintf("%s.go", api.Name.SnakeCase()))
 
-	w.Imports.Add("errors")
-	if walkers.ApiHasType(api, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ApiHasType(api, spec.TypeJson) {
-		w.Imports.Add("encoding/json")
-	}
-	if walkers.ApiHasType(api, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ApiHasType(api, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
-	if walkers.ApiHasNonSingleResponse(api) {
-		w.Imports.Module(g.Modules.ServicesApi(api))
-	}
-	if walkers.ApiIsUsingModels(api) {
-		w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
-	}
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
+	w.Imports.Module(g.Modules.Files)
+	w.Imports.Module(g.Modules.ServicesApi(api))
+	w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
 
 	w.Line(`type %s struct{}`, serviceTypeName(api))
 	w.EmptyLine()
 	apiPackage := api.Name.SnakeCase()
 	for _, operation := range api.Operations {
 		w.Line(`func (service *%s) %s {`, serviceTypeName(api), g.operationSignature(&operation, &apiPackage))
-		singleEmptyResponse := len(operation.Responses) == 1 && operation.Responses[0].Body.IsEmpty()
-		if singleEmptyResponse {
+		if singleEmptyResponse(&operation) {
 			w.Line(`  return errors.New("implementation has not added yet")`)
 		} else {
 			w.Line(`  return nil, errors.New("implementation has not added yet")`)
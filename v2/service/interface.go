@@ -3,44 +3,39 @@ package service
 import (
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
-	"github.com/specgen-io/specgen-golang/v2/walkers"
 	"github.com/specgen-io/specgen-golang/v2/writer"
 )
 
-func (g *Generator) ServicesInterfaces(version *spec.Version) []generator.CodeFile {
+func (g *Generator) ServicesInterfaces(version *spec.Version) ([]generator.CodeFile, error) {
 	files := []generator.CodeFile{}
 	for _, api := range version.Http.Apis {
-		files = append(files, *g.serviceInterface(&api))
+		file, err := g.serviceInterface(&api)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
 	}
-	return files
+	return files, nil
 }
 
-func (g *Generator) serviceInterface(api *spec.Api) *generator.CodeFile {
+func (g *Generator) serviceInterface(api *spec.Api) (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ServicesApi(api), "server.go")
 
-	if walkers.ApiHasType(api, spec.TypeDate) {
-		w.Imports.Add("cloud.google.com/go/civil")
-	}
-	if walkers.ApiHasType(api, spec.TypeJson) {
-		w.Imports.Add("encoding/json")
-	}
-	if walkers.ApiHasType(api, spec.TypeUuid) {
-		w.Imports.Add("github.com/google/uuid")
-	}
-	if walkers.ApiHasType(api, spec.TypeDecimal) {
-		w.Imports.Add("github.com/shopspring/decimal")
-	}
-	if walkers.ApiHasMultiResponsesWithEmptyBody(api) {
-		w.Imports.Module(g.Modules.Empty)
-	}
-	if walkers.ApiIsUsingModels(api) {
-		w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
-	}
-	if walkers.ApiIsUsingErrorModels(api) {
-		w.Imports.Module(g.Modules.HttpErrorsModels)
-	}
+	w.Imports.Add("cloud.google.com/go/civil")
+	w.Imports.Add("github.com/google/uuid")
+	w.Imports.Add("github.com/shopspring/decimal")
+	w.Imports.Module(g.Modules.Files)
+	w.Imports.Module(g.Modules.Empty)
+	w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
+	w.Imports.Module(g.Modules.HttpErrorsModels)
 
 	for _, operation := range api.Operations {
+		for _, response := range operation.Responses {
+			if response.HasHeaders() {
+				g.ResponseHeaders(w, &response)
+				w.EmptyLine()
+			}
+		}
 		if len(operation.Responses) > 1 {
 			g.Response(w, &operation)
 			w.EmptyLine()
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"strings"
+)
+
+const middlewaresVar = "middlewares"
+
+func operationMetaName(operation *spec.NamedOperation) string {
+	return fmt.Sprintf("operation%s", operation.Name.PascalCase())
+}
+
+func operationMeta(w *writer.Writer, operation *spec.NamedOperation, url string) {
+	security := []string{}
+	for _, name := range operation.EffectiveSecurity().SchemesNames() {
+		security = append(security, fmt.Sprintf(`"%s"`, name))
+	}
+	w.Line(`%s := &middleware.Operation{Id: "%s.%s", Api: "%s", Version: "%s", Method: "%s", Url: "%s", Security: []string{%s}}`,
+		operationMetaName(operation),
+		operation.InApi.Name.Source,
+		operation.Name.Source,
+		operation.InApi.Name.Source,
+		operation.InApi.InHttp.InVersion.Name.Source,
+		casee.ToUpperCase(operation.Endpoint.Method),
+		url,
+		strings.Join(security, ", "),
+	)
+}
+
+func operationHandlerName(operation *spec.NamedOperation) string {
+	return fmt.Sprintf("handle%s", operation.Name.PascalCase())
+}
+
+func applyMiddlewares(operation *spec.NamedOperation) string {
+	return fmt.Sprintf(`middleware.Apply(%s, %s, func(res http.ResponseWriter, req *http.Request) {`, operationMetaName(operation), middlewaresVar)
+}
+
+func (g *Generator) Middleware() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Middleware, `middleware.go`)
+	w.Lines(`
+import (
+	"net/http"
+)
+
+type Operation struct {
+	Id       string
+	Api      string
+	Version  string
+	Method   string
+	Url      string
+	Security []string
+}
+
+type Middleware func(operation *Operation, next http.HandlerFunc) http.HandlerFunc
+
+func Apply(operation *Operation, middlewares []Middleware, handler http.HandlerFunc) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](operation, handler)
+	}
+	return handler
+}
+`)
+	return w.ToCodeFile()
+}
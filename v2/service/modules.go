@@ -13,17 +13,25 @@ type Modules struct {
 	routing       map[string]module.Module
 	Root          module.Module
 	Empty         module.Module
+	Files         module.Module
 	ParamsParser  module.Module
 	Respond       module.Module
 	ContentType   module.Module
+	Auth          module.Module
+	Logging       module.Module
+	Middleware    module.Module
 }
 
 func NewModules(moduleName, generatePath, servicesPath string, specification *spec.Spec) *Modules {
 	root := module.New(moduleName, generatePath)
 	empty := root.Submodule("empty")
+	files := root.Submodule("files")
 	paramsParser := root.Submodule("paramsparser")
 	respond := root.Submodule("respond")
 	contentType := root.Submodule("contenttype")
+	auth := root.Submodule("auth")
+	logging := root.Submodule("logging")
+	middleware := root.Submodule("middleware")
 
 	servicesApis := map[string]map[string]module.Module{}
 	servicesImpls := map[string]module.Module{}
@@ -46,9 +54,13 @@ func NewModules(moduleName, generatePath, servicesPath string, specification *sp
 		routing,
 		root,
 		empty,
+		files,
 		paramsParser,
 		respond,
 		contentType,
+		auth,
+		logging,
+		middleware,
 	}
 }
 
@@ -56,17 +56,20 @@ func parserMethodNamePlain(typ *spec.TypeDef) string {
 		return "Date"
 	case spec.TypeDateTime:
 		return "DateTime"
+	case spec.TypeFile:
+		return "File"
 	default:
 		panic(fmt.Sprintf("Unsupported string param type: %v", typ.Plain))
 	}
 }
 
-func (g *Generator) GenerateParamsParser() *generator.CodeFile {
+func (g *Generator) GenerateParamsParser() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ParamsParser, `parser.go`)
 
 	w.Lines(`
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -92,6 +95,14 @@ func New(values map[string][]string, parseCommaSeparatedArray bool) *ParamsParse
 	return &ParamsParser{values, parseCommaSeparatedArray, []ParsingError{}}
 }
 
+func CookieValues(cookies []*http.Cookie) map[string][]string {
+	values := map[string][]string{}
+	for _, cookie := range cookies {
+		values[cookie.Name] = append(values[cookie.Name], cookie.Value)
+	}
+	return values
+}
+
 func (parser *ParamsParser) parseInt(name string, s string) int {
 	v, err := strconv.Atoi(s)
 	parser.addParsingError(name, "int", err)
@@ -620,29 +631,86 @@ func (parser *ParamsParser) StringEnumArray(name string, values []string) []stri
 	return w.ToCodeFile()
 }
 
-func (g *Generator) GenerateFormDataParamsParser() *generator.CodeFile {
+func (g *Generator) GenerateFormDataParamsParser() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ParamsParser, `form_data_parser.go`)
 
-	w.Lines(`
+	w.Template(
+		map[string]string{
+			`FilesPackage`: g.Modules.Files.Package,
+		}, `
 import (
+	"fmt"
+	"mime/multipart"
 	"net/http"
+	"[[.FilesPackage]]"
 )
 
-func NewFormDataParser(req *http.Request, parseCommaSeparatedArray bool) (*ParamsParser, error) {
+type FormDataParser struct {
+	*ParamsParser
+	form   *multipart.Form
+	opened []multipart.File
+}
+
+func NewFormDataParser(req *http.Request, parseCommaSeparatedArray bool) (*FormDataParser, error) {
 	const defaultMaxMemory = 32 << 20 // 32 MB
 	err := req.ParseMultipartForm(defaultMaxMemory)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ParamsParser{req.PostForm, parseCommaSeparatedArray, []ParsingError{}}, nil
+	return &FormDataParser{&ParamsParser{req.PostForm, parseCommaSeparatedArray, []ParsingError{}}, req.MultipartForm, nil}, nil
+}
+
+// Close closes files opened by the parser and removes temporary files of the multipart form.
+func (parser *FormDataParser) Close() error {
+	var result error
+	for _, file := range parser.opened {
+		if err := file.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	parser.opened = nil
+	if err := parser.form.RemoveAll(); err != nil && result == nil {
+		result = err
+	}
+	return result
+}
+
+func (parser *FormDataParser) File(name string) files.File {
+	if len(parser.form.File[name]) == 0 {
+		parser.addValidationError(name, "missing", "File is missing")
+		return files.File{}
+	}
+	file := parser.FileNullable(name)
+	if file == nil {
+		return files.File{}
+	}
+	return *file
+}
+
+func (parser *FormDataParser) FileNullable(name string) *files.File {
+	fileHeaders := parser.form.File[name]
+	if len(fileHeaders) == 0 {
+		return nil
+	}
+	if len(fileHeaders) > 1 {
+		parser.addValidationError(name, "too_many_values", fmt.Sprintf("expected one file, found: PERCENT_d", len(fileHeaders)))
+		return nil
+	}
+	content, err := fileHeaders[0].Open()
+	if err != nil {
+		parser.addParsingError(name, "file", err)
+		return nil
+	}
+	parser.opened = append(parser.opened, content)
+	return &files.File{Name: fileHeaders[0].Filename, ContentType: fileHeaders[0].Header.Get("Content-Type"), Content: content}
 }
 `)
 
 	return w.ToCodeFile()
 }
 
-func (g *Generator) GenerateFormUrlencodedParamsParser() *generator.CodeFile {
+func (g *Generator) GenerateFormUrlencodedParamsParser() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.ParamsParser, `form_urlencoded_parser.go`)
 
 	w.Lines(`
@@ -662,3 +730,22 @@ func NewFormUrlencodedParser(req *http.Request, parseCommaSeparatedArray bool) (
 
 	return w.ToCodeFile()
 }
+
+func (g *Generator) GenerateParamsParserValidation() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.ParamsParser, `validation.go`)
+	w.Template(
+		map[string]string{
+			`ValidationModule`: g.Modules.Validation.Package,
+		}, `
+import (
+	"[[.ValidationModule]]"
+)
+
+func (parser *ParamsParser) Check(err *validation.Error) {
+	if err != nil {
+		parser.Errors = append(parser.Errors, ParsingError(*err))
+	}
+}
+`)
+	return w.ToCodeFile()
+}
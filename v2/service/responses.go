@@ -11,6 +11,14 @@ func responseTypeName(operation *spec.NamedOperation) string {
 	return fmt.Sprintf(`%sResponse`, operation.Name.PascalCase())
 }
 
+func responseHeadersTypeName(response *spec.OperationResponse) string {
+	return fmt.Sprintf(`%s%s`, response.Operation.Name.PascalCase(), response.Name.PascalCase())
+}
+
+func singleEmptyResponse(operation *spec.NamedOperation) bool {
+	return len(operation.Responses) == 1 && operation.Responses[0].Body.IsEmpty() && !operation.Responses[0].HasHeaders()
+}
+
 func respondJson(logFields, resVar, statusCode, dataVar string) string {
 	return fmt.Sprintf(`respond.Json(%s, %s, %s, %s)`, logFields, resVar, statusCode, dataVar)
 }
@@ -19,19 +27,65 @@ func respondText(logFields, resVar, statusCode, dataVar string) string {
 	return fmt.Sprintf(`respond.Text(%s, %s, %s, %s)`, logFields, resVar, statusCode, dataVar)
 }
 
+func respondBinary(logFields, resVar, statusCode, dataVar string) string {
+	return fmt.Sprintf(`respond.Binary(%s, %s, %s, %s)`, logFields, resVar, statusCode, dataVar)
+}
+
+func respondStream(streamFunc, logFields, resVar, statusCode, dataVar string) string {
+	return fmt.Sprintf(`respond.%s(req.Context(), %s, %s, %s, %s)`, streamFunc, logFields, resVar, statusCode, dataVar)
+}
+
 func respondEmpty(logFields, resVar, statusCode string) string {
 	return fmt.Sprintf(`respond.Empty(%s, %s, %s)`, logFields, resVar, statusCode)
 }
 
 func writeResponse(w *writer.Writer, logFieldsName string, response *spec.Response, responseVar string) {
+	textVar := `*` + responseVar
+	jsonVar := responseVar
+	binaryVar := responseVar
+	streamVar := responseVar
+	if response.HasHeaders() {
+		writeResponseHeaders(w, response, responseVar)
+		textVar = responseVar + `.Body`
+		jsonVar = responseVar + `.Body`
+		binaryVar = responseVar + `.Body`
+		streamVar = responseVar + `.Body`
+	}
 	if response.Body.Is(spec.ResponseBodyEmpty) {
 		w.Line(respondEmpty(logFieldsName, `res`, spec.HttpStatusCode(response.Name)))
 	}
 	if response.Body.Is(spec.ResponseBodyString) {
-		w.Line(respondText(logFieldsName, `res`, spec.HttpStatusCode(response.Name), `*`+responseVar))
+		w.Line(respondText(logFieldsName, `res`, spec.HttpStatusCode(response.Name), textVar))
 	}
 	if response.Body.Is(spec.ResponseBodyJson) {
-		w.Line(respondJson(logFieldsName, `res`, spec.HttpStatusCode(response.Name), responseVar))
+		w.Line(respondJson(logFieldsName, `res`, spec.HttpStatusCode(response.Name), jsonVar))
+	}
+	if response.Body.Is(spec.ResponseBodyBinary) {
+		w.Line(respondBinary(logFieldsName, `res`, spec.HttpStatusCode(response.Name), binaryVar))
+	}
+	if response.Body.Is(spec.ResponseBodyNdjson) {
+		w.Line(respondStream(`Ndjson`, logFieldsName, `res`, spec.HttpStatusCode(response.Name), streamVar))
+	}
+	if response.Body.Is(spec.ResponseBodySse) {
+		w.Line(respondStream(`Sse`, logFieldsName, `res`, spec.HttpStatusCode(response.Name), streamVar))
+	}
+}
+
+func writeResponseHeaders(w *writer.Writer, response *spec.Response, responseVar string) {
+	for _, header := range response.Headers {
+		fieldVar := fmt.Sprintf(`%s.%s`, responseVar, header.Name.PascalCase())
+		switch header.Type.Definition.Node {
+		case spec.NullableType:
+			w.Line(`if %s != nil {`, fieldVar)
+			w.Line(`  res.Header().Set("%s", respond.HeaderValue(*%s))`, header.Name.Source, fieldVar)
+			w.Line(`}`)
+		case spec.ArrayType:
+			w.Line(`for _, value := range %s {`, fieldVar)
+			w.Line(`  res.Header().Add("%s", respond.HeaderValue(value))`, header.Name.Source)
+			w.Line(`}`)
+		default:
+			w.Line(`res.Header().Set("%s", respond.HeaderValue(%s))`, header.Name.Source, fieldVar)
+		}
 	}
 }
 
@@ -39,38 +93,160 @@ func (g *Generator) Response(w *writer.Writer, operation *spec.NamedOperation) {
 	w.Line(`type %s struct {`, responseTypeName(operation))
 	w.Indent()
 	for _, response := range operation.Responses {
-		w.LineAligned(`%s *%s`, response.Name.PascalCase(), g.Types.ResponseBodyGoType(&response.Body))
+		if response.HasHeaders() {
+			w.LineAligned(`%s *%s`, response.Name.PascalCase(), responseHeadersTypeName(&response))
+		} else {
+			w.LineAligned(`%s %s`, response.Name.PascalCase(), g.Types.ResponseBodyGoTypeRef(&response.Body))
+		}
 	}
 	w.Unindent()
 	w.Line(`}`)
 }
 
-func (g *Generator) ResponseHelperFunctions() *generator.CodeFile {
+func (g *Generator) ResponseHeaders(w *writer.Writer, response *spec.OperationResponse) {
+	w.Line(`type %s struct {`, responseHeadersTypeName(response))
+	w.Indent()
+	if !response.Body.IsEmpty() {
+		w.LineAligned(`Body %s`, g.Types.ResponseBodyGoType(&response.Body))
+	}
+	for _, header := range response.Headers {
+		w.LineAligned(`%s %s`, header.Name.PascalCase(), g.Types.GoType(&header.Type.Definition))
+	}
+	w.Unindent()
+	w.Line(`}`)
+}
+
+func (g *Generator) ResponseHelperFunctions() (*generator.CodeFile, error) {
 	w := writer.New(g.Modules.Respond, `respond.go`)
-	w.Lines(`
+	w.Template(
+		map[string]string{
+			`LoggingPackage`: g.Modules.Logging.Package,
+		}, `
 import (
+	"context"
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
+	"fmt"
+	"io"
+	"[[.LoggingPackage]]"
 	"net/http"
+	"strconv"
 )
 
-func Json(logFields log.Fields, res http.ResponseWriter, statusCode int, data interface{}) {
+func Json(logFields logging.Fields, res http.ResponseWriter, statusCode int, data interface{}) {
 	res.Header().Set("Content-Type", "application/json")
 	res.WriteHeader(statusCode)
 	json.NewEncoder(res).Encode(data)
-	log.WithFields(logFields).WithField("status", statusCode).Info("Completed request")
+	logging.Info(logFields.With("status", statusCode), "Completed request")
 }
 
-func Text(logFields log.Fields, res http.ResponseWriter, statusCode int, data string) {
+func Text(logFields logging.Fields, res http.ResponseWriter, statusCode int, data string) {
 	res.Header().Set("Content-Type", "text/plain")
 	res.WriteHeader(statusCode)
 	res.Write([]byte(data))
-	log.WithFields(logFields).WithField("status", statusCode).Info("Completed request")
+	logging.Info(logFields.With("status", statusCode), "Completed request")
+}
+
+func Binary(logFields logging.Fields, res http.ResponseWriter, statusCode int, data io.ReadCloser) {
+	defer data.Close()
+	res.Header().Set("Content-Type", "application/octet-stream")
+	res.WriteHeader(statusCode)
+	_, err := io.Copy(res, data)
+	if err != nil {
+		logging.Error(logFields.With("error", err.Error()), "Failed to write response body")
+	}
+	logging.Info(logFields.With("status", statusCode), "Completed request")
+}
+
+// Ndjson writes every item as a json line, a failure of the stream is reported with the last line {"$error":{"message":"..."}}.
+func Ndjson[T any](ctx context.Context, logFields logging.Fields, res http.ResponseWriter, statusCode int, items func(send func(T) error) error) {
+	res.Header().Set("Content-Type", "application/x-ndjson")
+	stream(ctx, logFields, res, statusCode, items, func(data []byte) []byte {
+		return append(data, '\n')
+	}, func(data []byte) []byte {
+		return []byte("{\"$error\":" + string(data) + "}\n")
+	})
+}
+
+// Sse writes every item as a data event, a failure of the stream is reported with the last event of the error type.
+func Sse[T any](ctx context.Context, logFields logging.Fields, res http.ResponseWriter, statusCode int, items func(send func(T) error) error) {
+	res.Header().Set("Content-Type", "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	stream(ctx, logFields, res, statusCode, items, func(data []byte) []byte {
+		return []byte("data: " + string(data) + "\n\n")
+	}, func(data []byte) []byte {
+		return []byte("event: error\ndata: " + string(data) + "\n\n")
+	})
 }
 
-func Empty(logFields log.Fields, res http.ResponseWriter, statusCode int) {
+func stream[T any](ctx context.Context, logFields logging.Fields, res http.ResponseWriter, statusCode int, items func(send func(T) error) error, frame func([]byte) []byte, errorFrame func([]byte) []byte) {
 	res.WriteHeader(statusCode)
-	log.WithFields(logFields).WithField("status", statusCode).Info("Completed request")
+	flusher, canFlush := res.(http.Flusher)
+	if canFlush {
+		flusher.Flush()
+	}
+	var writeErr error
+	err := items(func(item T) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		_, err = res.Write(frame(data))
+		if err != nil {
+			writeErr = err
+			return err
+		}
+		if canFlush {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if ctx.Err() != nil {
+		logging.Info(logFields.With("status", statusCode), "Stream closed by client")
+		return
+	}
+	if writeErr != nil {
+		logging.Error(logFields.With("error", writeErr.Error()), "Failed to write stream item")
+		return
+	}
+	if err != nil {
+		logging.Error(logFields.With("error", err.Error()), "Stream terminated with error")
+		data, _ := json.Marshal(map[string]string{"message": "Internal server error"})
+		res.Write(errorFrame(data))
+		if canFlush {
+			flusher.Flush()
+		}
+		return
+	}
+	logging.Info(logFields.With("status", statusCode), "Completed request")
+}
+
+func Empty(logFields logging.Fields, res http.ResponseWriter, statusCode int) {
+	res.WriteHeader(statusCode)
+	logging.Info(logFields.With("status", statusCode), "Completed request")
+}
+
+func HeaderValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("PERCENT_v", v)
+	}
 }
 `)
 	return w.ToCodeFile()
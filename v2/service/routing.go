@@ -0,0 +1,304 @@
+package service
+
+import (
+	"fmt"
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/walkers"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"strings"
+)
+
+type router interface {
+	routerType() string
+	importRouter(w *writer.Writer)
+	endpointUrl(operation *spec.NamedOperation) string
+	addRoute(w *writer.Writer, operation *spec.NamedOperation, url string, handler func(w *writer.Writer))
+	urlParamsParser(operation *spec.NamedOperation) string
+	urlParamName(param *spec.NamedParam) string
+}
+
+type routing struct {
+	Types   *types.Types
+	Models  models.Generator
+	Modules *Modules
+	router  router
+}
+
+func newRouting(types *types.Types, models models.Generator, modules *Modules, router router) *routing {
+	return &routing{types, models, modules, router}
+}
+
+func (g *routing) Routings(version *spec.Version) ([]generator.CodeFile, error) {
+	files := []generator.CodeFile{}
+	for _, api := range version.Http.Apis {
+		file, err := g.routing(&api)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
+	}
+	return files, nil
+}
+
+func (g *routing) routing(api *spec.Api) (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Routing(api.InHttp.InVersion), fmt.Sprintf("%s.go", api.Name.SnakeCase()))
+
+	g.router.importRouter(w)
+	w.Imports.Module(g.Modules.Logging)
+	w.Imports.Module(g.Modules.ContentType)
+	w.Imports.Module(g.Modules.ServicesApi(api))
+	w.Imports.Module(g.Modules.HttpErrors)
+	w.Imports.Module(g.Modules.HttpErrorsModels)
+	w.Imports.Module(g.Modules.Models(api.InHttp.InVersion))
+	w.Imports.Module(g.Modules.ParamsParser)
+	w.Imports.Module(g.Modules.Respond)
+	w.Imports.Module(g.Modules.Middleware)
+	w.Imports.Module(g.Modules.Validation)
+	w.Imports.Module(g.Modules.Auth)
+	if walkers.ApiIsSecured(api) {
+		w.Line(`func %s(router %s, %s %s, %s auth.Authenticator, %s httperrors.Mapper, %s ...middleware.Middleware) {`, addRoutesMethodName(api), g.router.routerType(), serviceInterfaceTypeVar(api), g.Modules.ServicesApi(api).Get(serviceInterfaceName), authenticatorVar, errorMapperVar, middlewaresVar)
+	} else {
+		w.Line(`func %s(router %s, %s %s, %s httperrors.Mapper, %s ...middleware.Middleware) {`, addRoutesMethodName(api), g.router.routerType(), serviceInterfaceTypeVar(api), g.Modules.ServicesApi(api).Get(serviceInterfaceName), errorMapperVar, middlewaresVar)
+	}
+	w.Indent()
+	if walkers.ApiIsSecured(api) {
+		authenticatorCheck(w, api)
+	}
+	for _, operation := range api.Operations {
+		url := g.router.endpointUrl(&operation)
+		w.Line(`%s := logging.Fields{"operationId": "%s.%s", "method": "%s", "url": "%s"}`, logFieldsName(&operation), operation.InApi.Name.Source, operation.Name.Source, casee.ToUpperCase(operation.Endpoint.Method), url)
+		operationMeta(w, &operation, url)
+		g.router.addRoute(w, &operation, url, func(w *writer.Writer) {
+			g.operation(w, &operation)
+		})
+		w.EmptyLine()
+	}
+	w.Unindent()
+	w.Line(`}`)
+
+	return w.ToCodeFile()
+}
+
+func endpointUrl(operation *spec.NamedOperation, urlParamFormat string) string {
+	url := operation.FullUrl()
+	if operation.Endpoint.UrlParams != nil && len(operation.Endpoint.UrlParams) > 0 {
+		for _, param := range operation.Endpoint.UrlParams {
+			url = strings.Replace(url, spec.UrlParamStr(&param), fmt.Sprintf(urlParamFormat, param.Name.Source), -1)
+		}
+	}
+	return url
+}
+
+func headerParamsNames(operation *spec.NamedOperation) []string {
+	names := []string{}
+	for _, param := range operation.HeaderParams {
+		names = append(names, param.Name.Source)
+	}
+	return names
+}
+
+func (g *routing) parserParameterCall(param *spec.NamedParam, paramName string, paramsParserName string) string {
+	parserParams := []string{fmt.Sprintf(`"%s"`, paramName)}
+	methodName, defaultParam := parserDefaultName(param)
+	isEnum := param.Type.Definition.Info.Model != nil && param.Type.Definition.Info.Model.IsEnum()
+	enumModel := param.Type.Definition.Info.Model
+	if isEnum {
+		parserParams = append(parserParams, fmt.Sprintf("%s.%s", types.VersionModelsPackage, g.Models.EnumValuesStrings(enumModel)))
+	}
+	if defaultParam != nil {
+		parserParams = append(parserParams, *defaultParam)
+	}
+	call := fmt.Sprintf(`%s.%s(%s)`, paramsParserName, methodName, strings.Join(parserParams, ", "))
+	if isEnum {
+		call = fmt.Sprintf(`%s.%s(%s)`, types.VersionModelsPackage, enumModel.Name.PascalCase(), call)
+	}
+	return call
+}
+
+func (g *routing) headerParsing(w *writer.Writer, operation *spec.NamedOperation) {
+	g.parametersParsing(w, operation, operation.HeaderParams, "header", "req.Header")
+}
+
+func (g *routing) queryParsing(w *writer.Writer, operation *spec.NamedOperation) {
+	g.parametersParsing(w, operation, operation.QueryParams, "query", "req.URL.Query()")
+}
+
+func (g *routing) cookieParsing(w *writer.Writer, operation *spec.NamedOperation) {
+	g.parametersParsing(w, operation, operation.CookieParams, "cookie", "paramsparser.CookieValues(req.Cookies())")
+}
+
+func (g *routing) urlParamsParsing(w *writer.Writer, operation *spec.NamedOperation) {
+	if operation.Endpoint.UrlParams != nil && len(operation.Endpoint.UrlParams) > 0 {
+		w.Line(`urlParams := %s`, g.router.urlParamsParser(operation))
+		for _, param := range operation.Endpoint.UrlParams {
+			w.Line(`%s := %s`, param.Name.CamelCase(), g.parserParameterCall(&param, g.router.urlParamName(&param), "urlParams"))
+		}
+		w.Line(`if len(urlParams.Errors) > 0 {`)
+		respondNotFound(w.Indented(), operation, g.Types, fmt.Sprintf(`"Failed to parse url parameters"`))
+		w.Line(`}`)
+	}
+}
+
+func (g *routing) parametersParsing(w *writer.Writer, operation *spec.NamedOperation, namedParams []spec.NamedParam, paramsParserName string, paramsValuesVar string) {
+	if namedParams != nil && len(namedParams) > 0 {
+		w.Line(`%s := paramsparser.New(%s, true)`, paramsParserName, paramsValuesVar)
+		for _, param := range namedParams {
+			w.Line(`%s := %s`, param.Name.CamelCase(), g.parserParameterCall(&param, param.Name.Source, paramsParserName))
+		}
+		paramsConstraintsChecks(w, namedParams, paramsParserName)
+		w.Line(`if len(%s.Errors) > 0 {`, paramsParserName)
+		respondBadRequest(w.Indented(), operation, g.Types, paramsParserName, fmt.Sprintf(`"Failed to parse %s"`, paramsParserName), fmt.Sprintf(`httperrors.Convert(%s.Errors)`, paramsParserName))
+		w.Line(`}`)
+	}
+}
+
+func (g *routing) serviceCallAndResponseCheck(w *writer.Writer, operation *spec.NamedOperation, responseVar string) {
+	singleEmptyResponse := singleEmptyResponse(operation)
+	serviceCall := serviceCall(serviceInterfaceTypeVar(operation.InApi), operation)
+	if singleEmptyResponse {
+		w.Line(`err = %s`, serviceCall)
+	} else {
+		w.Line(`%s, err := %s`, responseVar, serviceCall)
+	}
+	w.Line(`if err != nil {`)
+	respondServiceError(w.Indented(), operation, g.Types)
+	w.Line(`}`)
+	if !singleEmptyResponse {
+		w.Line(`if response == nil {`)
+		respondInternalServerError(w.Indented(), operation, g.Types, `"Service implementation returned nil"`)
+		w.Line(`}`)
+	}
+}
+
+func (g *routing) operation(w *writer.Writer, operation *spec.NamedOperation) {
+	w.Line(`logging.Debug(%s, "Received request")`, logFieldsName(operation))
+	w.Line(`var err error`)
+	if operation.IsSecured() {
+		authentication(w, operation, g.Types)
+	}
+	g.urlParamsParsing(w, operation)
+	g.headerParsing(w, operation)
+	g.queryParsing(w, operation)
+	g.cookieParsing(w, operation)
+	g.bodyParsing(w, operation)
+	g.serviceCallAndResponseCheck(w, operation, `response`)
+	g.response(w, operation, `response`)
+}
+
+func (g *routing) response(w *writer.Writer, operation *spec.NamedOperation, responseVar string) {
+	if len(operation.Responses) == 1 {
+		writeResponse(w, logFieldsName(operation), &operation.Responses[0].Response, responseVar)
+	} else {
+		for _, response := range operation.Responses {
+			responseVar := fmt.Sprintf("%s.%s", responseVar, response.Name.PascalCase())
+			w.Line(`if %s != nil {`, responseVar)
+			writeResponse(w.Indented(), logFieldsName(operation), &response.Response, responseVar)
+			w.Line(`  return`)
+			w.Line(`}`)
+		}
+		respondInternalServerError(w, operation, g.Types, `"Result from service implementation does not have anything in it"`)
+	}
+}
+
+func (g *routing) bodyParsing(w *writer.Writer, operation *spec.NamedOperation) {
+	if operation.BodyIs(spec.RequestBodyBinary) {
+		w.Line(`if !%s {`, callCheckContentType(logFieldsName(operation), fmt.Sprintf(`"%s"`, ContentType(operation)), "req", "res"))
+		w.Line(`  return`)
+		w.Line(`}`)
+	}
+	if operation.BodyIs(spec.RequestBodyString) {
+		w.Line(`if !%s {`, callCheckContentType(logFieldsName(operation), fmt.Sprintf(`"%s"`, ContentType(operation)), "req", "res"))
+		w.Line(`  return`)
+		w.Line(`}`)
+		w.Line(`bodyData, err := ioutil.ReadAll(req.Body)`)
+		w.Line(`if err != nil {`)
+		respondBadRequest(w.Indented(), operation, g.Types, "body", genFmtSprintf(`Reading request body failed: %s`, `err.Error()`), "nil")
+		w.Line(`}`)
+		w.Line(`body := string(bodyData)`)
+	}
+	if operation.BodyIs(spec.RequestBodyJson) {
+		w.Line(`if !%s {`, callCheckContentType(logFieldsName(operation), fmt.Sprintf(`"%s"`, ContentType(operation)), "req", "res"))
+		w.Line(`  return`)
+		w.Line(`}`)
+		w.Line(`var body %s`, g.Types.GoType(&operation.Body.Type.Definition))
+		w.Line(`err = json.NewDecoder(req.Body).Decode(&body)`)
+		w.Line(`if err != nil {`)
+		w.Line(`  var errors []errmodels.ValidationError = nil`)
+		w.Line(`  if unmarshalError, ok := err.(*json.UnmarshalTypeError); ok {`)
+		w.Line(`    message := fmt.Sprintf("Failed to parse JSON, field: PERCENT_s", unmarshalError.Field)`)
+		w.Line(`    errors = []errmodels.ValidationError{{Path: unmarshalError.Field, Code: "parsing_failed", Message: &message}}`)
+		w.Line(`  }`)
+		respondBadRequest(w.Indented(), operation, g.Types, "body", `"Failed to parse body"`, "errors")
+		w.Line(`}`)
+		bodyValidation(w, operation, g.Types)
+	}
+	if operation.BodyIs(spec.RequestBodyFormData) || operation.BodyIs(spec.RequestBodyFormUrlEncoded) {
+		w.Line(`if !%s {`, callCheckContentType(logFieldsName(operation), fmt.Sprintf(`"%s"`, ContentType(operation)), "req", "res"))
+		w.Line(`  return`)
+		w.Line(`}`)
+		w.Line(`formBody, err := paramsparser.New%sParser(req, true)`, casee.ToPascalCase(formBodyTypeName(operation)))
+		w.Line(`if err != nil {`)
+		respondBadRequest(w.Indented(), operation, g.Types, "body", `"Failed to parse body"`, fmt.Sprintf(`[]errmodels.ValidationError{{Path: "", Code: "%s_parse_failed"}}`, formBodyTypeName(operation)))
+		w.Line(`}`)
+		if operation.BodyIs(spec.RequestBodyFormData) {
+			w.Line(`defer formBody.Close()`)
+		}
+		for _, param := range operation.Body.FormData {
+			w.Line(`%s := %s`, param.Name.CamelCase(), g.parserParameterCall(&param, param.Name.Source, "formBody"))
+		}
+		for _, param := range operation.Body.FormUrlEncoded {
+			w.Line(`%s := %s`, param.Name.CamelCase(), g.parserParameterCall(&param, param.Name.Source, "formBody"))
+		}
+		paramsConstraintsChecks(w, operation.Body.FormData, "formBody")
+		paramsConstraintsChecks(w, operation.Body.FormUrlEncoded, "formBody")
+		w.Line(`if len(formBody.Errors) > 0 {`)
+		respondBadRequest(w.Indented(), operation, g.Types, "body", fmt.Sprintf(`"Failed to parse body"`), fmt.Sprintf(`httperrors.Convert(formBody.Errors)`))
+		w.Line(`}`)
+	}
+}
+
+func (g *routing) RootRouting(specification *spec.Spec) (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.Root, "spec.go")
+
+	g.router.importRouter(w)
+	for _, version := range specification.Versions {
+		w.Imports.ModuleAliased(g.Modules.Routing(&version).Aliased(routingPackageAlias(&version)))
+		for _, api := range version.Http.Apis {
+			w.Imports.ModuleAliased(g.Modules.ServicesApi(&api).Aliased(apiPackageAlias(&api)))
+		}
+	}
+	w.Imports.Module(g.Modules.Auth)
+	w.Imports.Module(g.Modules.HttpErrors)
+	w.Imports.Module(g.Modules.Middleware)
+	w.Line(`type Services struct {`)
+	for _, version := range specification.Versions {
+		for _, api := range version.Http.Apis {
+			apiModule := g.Modules.ServicesApi(&api).Aliased(apiPackageAlias(&api))
+			w.LineAligned(`  %s %s`, serviceApiPublicNameVersioned(&api), apiModule.Get(serviceInterfaceName))
+		}
+	}
+	if len(specification.Security) > 0 {
+		w.LineAligned(`  Authenticator auth.Authenticator`)
+	}
+	w.LineAligned(`  ErrorMapper httperrors.Mapper`)
+	w.Line(`}`)
+	w.EmptyLine()
+	w.Line(`func AddRoutes(router %s, services Services, middlewares ...middleware.Middleware) {`, g.router.routerType())
+	for _, version := range specification.Versions {
+		routingModule := g.Modules.Routing(&version).Aliased(routingPackageAlias(&version))
+		for _, api := range version.Http.Apis {
+			if walkers.ApiIsSecured(&api) {
+				w.Line(`  %s(router, services.%s, services.Authenticator, services.ErrorMapper, middlewares...)`, routingModule.Get(addRoutesMethodName(&api)), serviceApiPublicNameVersioned(&api))
+			} else {
+				w.Line(`  %s(router, services.%s, services.ErrorMapper, middlewares...)`, routingModule.Get(addRoutesMethodName(&api)), serviceApiPublicNameVersioned(&api))
+			}
+		}
+	}
+	w.Line(`}`)
+
+	return w.ToCodeFile()
+}
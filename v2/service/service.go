@@ -2,34 +2,63 @@ package service
 
 import (
 	"github.com/specgen-io/specgen-golang/v2/empty"
+	"github.com/specgen-io/specgen-golang/v2/files"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/goven/openapi"
 	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/logging"
 )
 
-func GenerateService(specification *spec.Spec, jsonmode, server, moduleName, swaggerPath, generatePath, servicesPath string) *generator.Sources {
+func GenerateService(specification *spec.Spec, jsonmode, server, loggingLib, moduleName, swaggerPath, generatePath, servicesPath string) (*generator.Sources, error) {
 	sources := generator.NewSources()
 
 	modules := NewModules(moduleName, generatePath, servicesPath, specification)
-	generator := NewGenerator(jsonmode, server, modules)
+	g := NewGenerator(jsonmode, server, modules)
 
-	sources.AddGenerated(empty.GenerateEmpty(generator.Modules.Empty))
-	sources.AddGenerated(generator.EnumsHelperFunctions())
-	sources.AddGenerated(generator.ResponseHelperFunctions())
-	sources.AddGenerated(generator.CheckContentType())
-	sources.AddGenerated(generator.GenerateParamsParser())
-	sources.AddGenerated(generator.GenerateFormDataParamsParser())
-	sources.AddGenerated(generator.GenerateFormUrlencodedParamsParser())
+	generators := []func() (*generator.CodeFile, error){
+		func() (*generator.CodeFile, error) { return empty.GenerateEmpty(modules.Empty) },
+		func() (*generator.CodeFile, error) { return files.GenerateFiles(modules.Files) },
+		func() (*generator.CodeFile, error) { return logging.GenerateLogging(modules.Logging, loggingLib) },
+		g.EnumsHelperFunctions,
+		g.ValidationHelperFunctions,
+		g.ResponseHelperFunctions,
+		g.CheckContentType,
+		g.GenerateParamsParser,
+		g.GenerateParamsParserValidation,
+		g.GenerateFormDataParamsParser,
+		g.GenerateFormUrlencodedParamsParser,
+		func() (*generator.CodeFile, error) { return g.Auth(specification.Security) },
+		g.Middleware,
+		func() (*generator.CodeFile, error) { return g.RootRouting(specification) },
+		g.GenerateUrlParamsCtor,
+	}
+	for _, generate := range generators {
+		file, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		sources.AddGenerated(file)
+	}
 
-	sources.AddGeneratedAll(generator.ErrorModels(specification.HttpErrors))
-	sources.AddGeneratedAll(generator.HttpErrors(&specification.HttpErrors.Responses))
+	errorModels, err := g.ErrorModels(specification.HttpErrors)
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGeneratedAll(errorModels)
+	httpErrors, err := g.HttpErrors(&specification.HttpErrors.Responses)
+	if err != nil {
+		return nil, err
+	}
+	sources.AddGeneratedAll(httpErrors)
 
-	sources.AddGenerated(generator.RootRouting(specification))
-	sources.AddGenerated(generator.GenerateUrlParamsCtor())
 	for _, version := range specification.Versions {
-		sources.AddGeneratedAll(generator.Routings(&version))
-		sources.AddGeneratedAll(generator.ServicesInterfaces(&version))
-		sources.AddGeneratedAll(generator.Models(&version))
+		for _, generate := range []func(*spec.Version) ([]generator.CodeFile, error){g.Routings, g.ServicesInterfaces, g.Models} {
+			files, err := generate(&version)
+			if err != nil {
+				return nil, err
+			}
+			sources.AddGeneratedAll(files)
+		}
 	}
 
 	if swaggerPath != "" {
@@ -38,9 +67,13 @@ func GenerateService(specification *spec.Spec, jsonmode, server, moduleName, swa
 
 	if servicesPath != "" {
 		for _, version := range specification.Versions {
-			sources.AddScaffoldedAll(generator.ServicesImpls(&version))
+			servicesImpls, err := g.ServicesImpls(&version)
+			if err != nil {
+				return nil, err
+			}
+			sources.AddScaffoldedAll(servicesImpls)
 		}
 	}
 
-	return sources
+	return sources, nil
 }
@@ -18,27 +18,36 @@ func (g *Generator) operationSignature(operation *spec.NamedOperation, apiPackag
 func (g *Generator) operationReturn(operation *spec.NamedOperation, responsePackageName *string) string {
 	if len(operation.Responses) == 1 {
 		response := operation.Responses[0]
+		if response.HasHeaders() {
+			return fmt.Sprintf(`(*%s, error)`, packagedName(responseHeadersTypeName(&response), responsePackageName))
+		}
 		if response.Body.IsEmpty() {
 			return `error`
 		} else {
-			return fmt.Sprintf(`(*%s, error)`, g.Types.GoType(&response.Body.Type.Definition))
+			return fmt.Sprintf(`(%s, error)`, g.Types.ResponseBodyGoTypeRef(&response.Body))
 		}
 	}
-	responseType := responseTypeName(operation)
-	if responsePackageName != nil {
-		responseType = *responsePackageName + "." + responseType
+	return fmt.Sprintf(`(*%s, error)`, packagedName(responseTypeName(operation), responsePackageName))
+}
+
+func packagedName(name string, packageName *string) string {
+	if packageName != nil {
+		return *packageName + "." + name
 	}
-	return fmt.Sprintf(`(*%s, error)`, responseType)
+	return name
 }
 
 func operationParams(types *types.Types, operation *spec.NamedOperation) []string {
-	params := []string{}
+	params := []string{"ctx context.Context"}
 	if operation.BodyIs(spec.RequestBodyString) {
 		params = append(params, fmt.Sprintf("body %s", types.GoType(&operation.Body.Type.Definition)))
 	}
 	if operation.BodyIs(spec.RequestBodyJson) {
 		params = append(params, fmt.Sprintf("body *%s", types.GoType(&operation.Body.Type.Definition)))
 	}
+	if operation.BodyIs(spec.RequestBodyBinary) {
+		params = append(params, "body io.Reader")
+	}
 	if operation.BodyIs(spec.RequestBodyFormData) {
 		for _, param := range operation.Body.FormData {
 			params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
@@ -55,6 +64,9 @@ func operationParams(types *types.Types, operation *spec.NamedOperation) []strin
 	for _, param := range operation.HeaderParams {
 		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
 	}
+	for _, param := range operation.CookieParams {
+		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
+	}
 	for _, param := range operation.Endpoint.UrlParams {
 		params = append(params, fmt.Sprintf("%s %s", param.Name.CamelCase(), types.GoType(&param.Type.Definition)))
 	}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+	"github.com/pinzolo/casee"
+	"github.com/specgen-io/specgen-golang/v2/goven/generator"
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+	"strings"
+)
+
+var Stdlib = "stdlib"
+
+type StdlibGenerator struct {
+	*routing
+}
+
+func NewStdlibGenerator(types *types.Types, models models.Generator, modules *Modules) *StdlibGenerator {
+	g := &StdlibGenerator{}
+	g.routing = newRouting(types, models, modules, g)
+	return g
+}
+
+func (g *StdlibGenerator) routerType() string {
+	return `*http.ServeMux`
+}
+
+func (g *StdlibGenerator) importRouter(w *writer.Writer) {
+	w.Imports.Add("net/http")
+}
+
+func (g *StdlibGenerator) endpointUrl(operation *spec.NamedOperation) string {
+	return endpointUrl(operation, "{%s}")
+}
+
+func (g *StdlibGenerator) endpointPattern(url string) string {
+	if strings.HasSuffix(url, "/") {
+		return url + "{$}"
+	}
+	return url
+}
+
+func (g *StdlibGenerator) addRoute(w *writer.Writer, operation *spec.NamedOperation, url string, handler func(w *writer.Writer)) {
+	w.Line(`router.HandleFunc("%s %s", %s`, casee.ToUpperCase(operation.Endpoint.Method), g.endpointPattern(url), applyMiddlewares(operation))
+	handler(w.Indented())
+	w.Line(`}))`)
+}
+
+func (g *StdlibGenerator) urlParamsParser(operation *spec.NamedOperation) string {
+	names := []string{}
+	for _, param := range operation.Endpoint.UrlParams {
+		names = append(names, fmt.Sprintf(`"%s"`, param.Name.Source))
+	}
+	return fmt.Sprintf(`paramsparser.NewUrlParser(req, []string{%s}, false)`, strings.Join(names, ", "))
+}
+
+func (g *StdlibGenerator) urlParamName(param *spec.NamedParam) string {
+	return param.Name.Source
+}
+
+func (g *StdlibGenerator) GenerateUrlParamsCtor() (*generator.CodeFile, error) {
+	w := writer.New(g.Modules.ParamsParser, `url_parser.go`)
+
+	w.Lines(`
+import (
+	"net/http"
+	"net/url"
+)
+
+func NewUrlParser(req *http.Request, names []string, parseCommaSeparatedArray bool) *ParamsParser {
+	values := make(url.Values)
+	for _, name := range names {
+		values.Add(name, req.PathValue(name))
+	}
+	return &ParamsParser{values, parseCommaSeparatedArray, []ParsingError{}}
+}
+`)
+
+	return w.ToCodeFile()
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/goven/spec"
+	"github.com/specgen-io/specgen-golang/v2/models"
+	"github.com/specgen-io/specgen-golang/v2/types"
+	"github.com/specgen-io/specgen-golang/v2/walkers"
+	"github.com/specgen-io/specgen-golang/v2/writer"
+)
+
+func paramsConstraintsChecks(w *writer.Writer, namedParams []spec.NamedParam, paramsParserName string) {
+	for _, param := range namedParams {
+		models.WriteConstraintsChecks(w, paramsParserName, &param.Type.Definition, param.Constraints, param.Name.Source, param.Name.CamelCase())
+	}
+}
+
+func bodyValidation(w *writer.Writer, operation *spec.NamedOperation, types *types.Types) {
+	if walkers.BodyHasConstraints(operation.Body) {
+		w.Line(`err = body.Validate()`)
+		w.Line(`if err != nil {`)
+		respondBadRequest(w.Indented(), operation, types, "body", `"Failed to validate body"`, "httperrors.ConvertValidation(err)")
+		w.Line(`}`)
+	}
+}
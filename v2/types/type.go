@@ -17,11 +17,22 @@ func NewTypes() *Types {
 func (types *Types) ResponseBodyGoType(body *spec.ResponseBody) string {
 	if body.IsEmpty() {
 		return EmptyType
+	} else if body.IsBinary() {
+		return BinaryType
+	} else if body.IsStream() {
+		return fmt.Sprintf("func(send func(%s) error) error", types.GoType(&body.Type.Definition))
 	} else {
 		return types.GoType(&body.Type.Definition)
 	}
 }
 
+func (types *Types) ResponseBodyGoTypeRef(body *spec.ResponseBody) string {
+	if body.IsBinary() || body.IsStream() {
+		return types.ResponseBodyGoType(body)
+	}
+	return "*" + types.ResponseBodyGoType(body)
+}
+
 func (types *Types) GoType(typ *spec.TypeDef) string {
 	return types.goType(typ, false)
 }
@@ -77,6 +88,8 @@ func (types *Types) plainType(typ *spec.TypeDef, samePackage bool) string {
 		return "civil.DateTime"
 	case spec.TypeJson:
 		return "json.RawMessage"
+	case spec.TypeFile:
+		return FileType
 	case spec.TypeEmpty:
 		return EmptyType
 	default:
@@ -97,3 +110,5 @@ func (types *Types) plainType(typ *spec.TypeDef, samePackage bool) string {
 }
 
 const EmptyType = `empty.Type`
+const FileType = `files.File`
+const BinaryType = `io.ReadCloser`
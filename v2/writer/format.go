@@ -0,0 +1,291 @@
+package writer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run gen_stdlib.go
+
+var versionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+var gopkgVersionSuffix = regexp.MustCompile(`\.v[0-9]+$`)
+
+func guessPackageNames(importPath string) []string {
+	name := path.Base(importPath)
+	names := []string{name}
+	if versionSuffix.MatchString(name) && path.Dir(importPath) != "." {
+		name = path.Base(path.Dir(importPath))
+		names = append(names, name)
+	}
+	name = gopkgVersionSuffix.ReplaceAllString(name, "")
+	name = strings.TrimPrefix(name, "go-")
+	return append(names, name)
+}
+
+func (self *imports) usedNames(importPath string) []string {
+	if alias := self.imports[importPath]; alias != "" {
+		return []string{alias}
+	}
+	if name, found := self.names[importPath]; found {
+		return []string{name}
+	}
+	return guessPackageNames(importPath)
+}
+
+type scope struct {
+	parent *scope
+	names  map[string]bool
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{parent, map[string]bool{}}
+}
+
+func (s *scope) declare(idents ...*ast.Ident) {
+	for _, ident := range idents {
+		if ident != nil {
+			s.names[ident.Name] = true
+		}
+	}
+}
+
+func (s *scope) declareFields(fields *ast.FieldList) {
+	if fields != nil {
+		for _, field := range fields.List {
+			s.declare(field.Names...)
+		}
+	}
+}
+
+func (s *scope) declares(name string) bool {
+	for current := s; current != nil; current = current.parent {
+		if current.names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// packageNamesVisitor collects identifiers used as qualifiers in selector expressions
+// which are not declared in any enclosing scope, so they can only refer to imported packages.
+type packageNamesVisitor struct {
+	scope *scope
+	used  map[string]bool
+}
+
+func (v *packageNamesVisitor) nested() *packageNamesVisitor {
+	return &packageNamesVisitor{newScope(v.scope), v.used}
+}
+
+func (v *packageNamesVisitor) walkFunc(recv *ast.FieldList, funcType *ast.FuncType, body *ast.BlockStmt) {
+	if recv != nil {
+		ast.Walk(v, recv)
+	}
+	ast.Walk(v, funcType)
+	if body != nil {
+		inner := v.nested()
+		inner.scope.declareFields(recv)
+		inner.scope.declareFields(funcType.TypeParams)
+		inner.scope.declareFields(funcType.Params)
+		inner.scope.declareFields(funcType.Results)
+		ast.Walk(inner, body)
+	}
+}
+
+func (v *packageNamesVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.SelectorExpr:
+		if ident, ok := n.X.(*ast.Ident); ok {
+			if !v.scope.declares(ident.Name) {
+				v.used[ident.Name] = true
+			}
+			return nil
+		}
+		ast.Walk(v, n.X)
+		return nil
+	case *ast.FuncDecl:
+		v.walkFunc(n.Recv, n.Type, n.Body)
+		return nil
+	case *ast.FuncLit:
+		v.walkFunc(nil, n.Type, n.Body)
+		return nil
+	case *ast.AssignStmt:
+		for _, expr := range n.Rhs {
+			ast.Walk(v, expr)
+		}
+		for _, expr := range n.Lhs {
+			if ident, ok := expr.(*ast.Ident); ok && n.Tok == token.DEFINE {
+				v.scope.declare(ident)
+			} else {
+				ast.Walk(v, expr)
+			}
+		}
+		return nil
+	case *ast.ValueSpec:
+		if n.Type != nil {
+			ast.Walk(v, n.Type)
+		}
+		for _, expr := range n.Values {
+			ast.Walk(v, expr)
+		}
+		v.scope.declare(n.Names...)
+		return nil
+	case *ast.TypeSpec:
+		v.scope.declare(n.Name)
+		return v
+	case *ast.RangeStmt:
+		ast.Walk(v, n.X)
+		inner := v.nested()
+		if n.Tok == token.DEFINE {
+			for _, expr := range []ast.Expr{n.Key, n.Value} {
+				if ident, ok := expr.(*ast.Ident); ok {
+					inner.scope.declare(ident)
+				}
+			}
+		}
+		ast.Walk(inner, n.Body)
+		return nil
+	case *ast.TypeSwitchStmt:
+		inner := v.nested()
+		if n.Init != nil {
+			ast.Walk(inner, n.Init)
+		}
+		ast.Walk(inner, n.Assign)
+		ast.Walk(inner, n.Body)
+		return nil
+	case *ast.BlockStmt, *ast.IfStmt, *ast.ForStmt, *ast.SwitchStmt, *ast.SelectStmt, *ast.CaseClause, *ast.CommClause:
+		return v.nested()
+	}
+	return v
+}
+
+func usedPackageNames(file *ast.File) map[string]bool {
+	fileScope := newScope(nil)
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				fileScope.declare(d.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					fileScope.declare(s.Name)
+				case *ast.ValueSpec:
+					fileScope.declare(s.Names...)
+				}
+			}
+		}
+	}
+	visitor := &packageNamesVisitor{fileScope, map[string]bool{}}
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			continue
+		}
+		ast.Walk(visitor, decl)
+	}
+	return visitor.used
+}
+
+func (self *imports) Fix(used map[string]bool) *imports {
+	fixed := NewImports()
+	provided := map[string]bool{}
+	for theImport, alias := range self.imports {
+		names := self.usedNames(theImport)
+		isUsed := alias == "_" || alias == "."
+		for _, name := range names {
+			isUsed = isUsed || used[name]
+		}
+		if isUsed {
+			fixed.imports[theImport] = alias
+			if name, found := self.names[theImport]; found {
+				fixed.names[theImport] = name
+			}
+			for _, name := range names {
+				provided[name] = true
+			}
+		}
+	}
+	for name := range used {
+		if theImport, found := standardPackages[name]; found && !provided[name] {
+			fixed.Add(theImport)
+		}
+	}
+	return fixed
+}
+
+func sourceCode(packageName string, imports *imports, code []string) string {
+	lines := []string{fmt.Sprintf("package %s", packageName), ``}
+	importsLines := imports.Lines()
+	if len(importsLines) > 0 {
+		lines = append(lines, importsLines...)
+		lines = append(lines, "")
+	}
+	lines = append(lines, code...)
+	return strings.Join(lines, "\n")
+}
+
+func formatError(filename string, source string, err error) error {
+	if errorsList, ok := err.(scanner.ErrorList); ok && len(errorsList) > 0 {
+		first := errorsList[0]
+		sourceLines := strings.Split(source, "\n")
+		message := fmt.Sprintf("generated code in %s is not valid Go: %s", filename, first.Error())
+		if first.Pos.Line > 0 && first.Pos.Line <= len(sourceLines) {
+			message += fmt.Sprintf("\n%d: %s", first.Pos.Line, sourceLines[first.Pos.Line-1])
+		}
+		return errors.New(message)
+	}
+	return fmt.Errorf("generated code in %s is not valid Go: %s", filename, err.Error())
+}
+
+func FormatSource(filename string, packageName string, imports *imports, code []string) (string, error) {
+	source := sourceCode(packageName, imports, code)
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, source, parser.SkipObjectResolution)
+	if err != nil {
+		return "", formatError(filename, source, err)
+	}
+
+	allImports := NewImports()
+	for theImport, name := range imports.names {
+		allImports.names[theImport] = name
+	}
+	for _, spec := range file.Imports {
+		theImport, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", formatError(filename, source, err)
+		}
+		alias := ""
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		allImports.AddAliased(theImport, alias)
+	}
+
+	body := ""
+	offset := fset.Position(file.Name.End()).Offset
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			body += source[offset:fset.Position(genDecl.Pos()).Offset]
+			offset = fset.Position(genDecl.End()).Offset
+		}
+	}
+	body += source[offset:]
+
+	source = sourceCode(packageName, allImports.Fix(usedPackageNames(file)), []string{body})
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		return "", formatError(filename, source, err)
+	}
+	return string(formatted), nil
+}
@@ -0,0 +1,189 @@
+package writer
+
+import (
+	"github.com/specgen-io/specgen-golang/v2/module"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+func TestFormatSource(t *testing.T) {
+	cases := []struct {
+		name     string
+		imports  *imports
+		code     string
+		expected string
+	}{
+		{
+			name:    "adds missing standard imports",
+			imports: NewImports(),
+			code: `
+func Write(res http.ResponseWriter, data []byte) {
+	res.Write([]byte(strings.TrimSpace(string(data))))
+}
+`,
+			expected: `
+package bla
+
+import (
+	"net/http"
+	"strings"
+)
+
+func Write(res http.ResponseWriter, data []byte) {
+	res.Write([]byte(strings.TrimSpace(string(data))))
+}
+`,
+		},
+		{
+			name:    "removes unused imports",
+			imports: NewImports().Add("fmt").Add("github.com/google/uuid").Add("strings"),
+			code: `
+func Format(value int) string {
+	return fmt.Sprintf("%d", value)
+}
+`,
+			expected: `
+package bla
+
+import (
+	"fmt"
+)
+
+func Format(value int) string {
+	return fmt.Sprintf("%d", value)
+}
+`,
+		},
+		{
+			name:    "keeps used aliased imports and drops unused ones",
+			imports: NewImports().AddAliased("github.com/google/uuid", "guid").AddAliased("github.com/shopspring/decimal", "dec"),
+			code: `
+var Id guid.UUID
+`,
+			expected: `
+package bla
+
+import (
+	guid "github.com/google/uuid"
+)
+
+var Id guid.UUID
+`,
+		},
+		{
+			name:    "recognizes modules with package name different from import path",
+			imports: NewImports().Module(module.Module{Package: "sample/spec/httperrors/models", Name: "errmodels"}).Module(module.New("sample", "spec/models")),
+			code: `
+var Error errmodels.NotFoundError
+`,
+			expected: `
+package bla
+
+import (
+	"sample/spec/httperrors/models"
+)
+
+var Error errmodels.NotFoundError
+`,
+		},
+		{
+			name:    "merges imports declared in code",
+			imports: NewImports().Add("fmt"),
+			code: `
+import (
+	"errors"
+	"fmt"
+)
+
+var Err = errors.New(fmt.Sprint("failed"))
+`,
+			expected: `
+package bla
+
+import (
+	"errors"
+	"fmt"
+)
+
+var Err = errors.New(fmt.Sprint("failed"))
+`,
+		},
+		{
+			name:    "does not import packages shadowed by local names",
+			imports: NewImports(),
+			code: `
+type Request struct {
+	Path string
+}
+
+func Path(url *Request, errors []string) string {
+	for _, json := range errors {
+		return json
+	}
+	if strings, ok := interface{}(url).(*Request); ok {
+		return strings.Path
+	}
+	return url.Path
+}
+`,
+			expected: `
+package bla
+
+type Request struct {
+	Path string
+}
+
+func Path(url *Request, errors []string) string {
+	for _, json := range errors {
+		return json
+	}
+	if strings, ok := interface{}(url).(*Request); ok {
+		return strings.Path
+	}
+	return url.Path
+}
+`,
+		},
+		{
+			name:    "resolves parameter types outside of function scope",
+			imports: NewImports(),
+			code: `
+func Value(context context.Context) any {
+	return context.Value("key")
+}
+`,
+			expected: `
+package bla
+
+import (
+	"context"
+)
+
+func Value(context context.Context) any {
+	return context.Value("key")
+}
+`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			code := strings.Split(strings.TrimSpace(c.code), "\n")
+			formatted, err := FormatSource("bla.go", "bla", c.imports, code)
+			assert.NilError(t, err)
+			assert.Equal(t, strings.TrimSpace(formatted), strings.TrimSpace(c.expected))
+		})
+	}
+}
+
+func TestFormatSourceInvalidCode(t *testing.T) {
+	code := []string{
+		`func Broken() {`,
+		`	return 1 +`,
+		`}`,
+	}
+	_, err := FormatSource("spec/bla.go", "bla", NewImports(), code)
+	assert.ErrorContains(t, err, "generated code in spec/bla.go is not valid Go: spec/bla.go:5:1: expected operand")
+	assert.ErrorContains(t, err, "\n5: }")
+}
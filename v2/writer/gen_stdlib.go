@@ -0,0 +1,87 @@
+//go:build ignore
+
+// This program generates stdlib.go: the table of standard library packages
+// that the formatter imports automatically when generated code refers to them.
+// Run it with go generate after switching to a new Go release.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var versionSuffix = regexp.MustCompile(`/v[0-9]+$`)
+
+func isHidden(importPath string) bool {
+	for _, part := range strings.Split(importPath, "/") {
+		if part == "internal" || part == "vendor" {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	out, err := exec.Command("go", "list", "-f", "{{.Name}} {{.ImportPath}}", "std").Output()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	candidates := map[string][]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		name, importPath, _ := strings.Cut(line, " ")
+		if name == "main" || isHidden(importPath) {
+			continue
+		}
+		candidates[name] = append(candidates[name], importPath)
+	}
+
+	packages := map[string]string{}
+	for name, importPaths := range candidates {
+		unversioned := []string{}
+		for _, importPath := range importPaths {
+			if !versionSuffix.MatchString(importPath) {
+				unversioned = append(unversioned, importPath)
+			}
+		}
+		if len(unversioned) == 1 {
+			packages[name] = unversioned[0]
+		}
+	}
+
+	names := make([]string, 0, len(packages))
+	for name := range packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_stdlib.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package writer")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// standardPackages maps package names to import paths of the standard library packages,")
+	fmt.Fprintln(&buf, "// names shared by several packages (e.g. rand, template) are left out and have to be imported explicitly.")
+	fmt.Fprintln(&buf, "var standardPackages = map[string]string{")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name, packages[name])
+	}
+	fmt.Fprintln(&buf, "}")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile("stdlib.go", source, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
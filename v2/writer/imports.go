@@ -8,14 +8,16 @@ import (
 
 type imports struct {
 	imports map[string]string
+	names   map[string]string
 }
 
 func NewImports() *imports {
-	return &imports{imports: make(map[string]string)}
+	return &imports{imports: make(map[string]string), names: make(map[string]string)}
 }
 
 func (self *imports) Module(module module.Module) *imports {
 	self.Add(module.Package)
+	self.names[module.Package] = module.Name
 	return self
 }
 
@@ -24,6 +26,7 @@ func (self *imports) ModuleAliased(module module.Module) *imports {
 		panic(fmt.Sprintf(`module %s does not have alias and can't imported as aliased'`, module.Package))
 	}
 	self.AddAliased(module.Package, module.Alias)
+	self.names[module.Package] = module.Name
 	return self
 }
 
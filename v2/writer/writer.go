@@ -1,10 +1,8 @@
 package writer
 
 import (
-	"fmt"
 	"github.com/specgen-io/specgen-golang/v2/goven/generator"
 	"github.com/specgen-io/specgen-golang/v2/module"
-	"strings"
 )
 
 func GoConfig() generator.Config {
@@ -32,15 +30,10 @@ func (w *Writer) IndentedWith(size int) *Writer {
 	return &Writer{w.Writer.IndentedWith(size), w.filename, w.module, w.Imports}
 }
 
-func (w *Writer) ToCodeFile() *generator.CodeFile {
-	lines := []string{fmt.Sprintf("package %s", w.module.Name), ``}
-
-	imports := w.Imports.Lines()
-	if len(imports) > 0 {
-		lines = append(lines, imports...)
-		lines = append(lines, "")
+func (w *Writer) ToCodeFile() (*generator.CodeFile, error) {
+	code, err := FormatSource(w.filename, w.module.Name, w.Imports, w.Code())
+	if err != nil {
+		return nil, err
 	}
-	lines = append(lines, w.Code()...)
-	code := strings.Join(lines, "\n")
-	return &generator.CodeFile{w.filename, code}
+	return &generator.CodeFile{w.filename, code}, nil
 }